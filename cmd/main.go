@@ -10,10 +10,18 @@ import (
 	mongodb "github.com/ose-micro/mongo"
 	"github.com/ose-micro/nats"
 	"github.com/ose-micro/postgres"
+	"github.com/ose-micro/postman/internal/api/bounce"
 	"github.com/ose-micro/postman/internal/api/bus"
+	"github.com/ose-micro/postman/internal/api/dispatcher"
 	"github.com/ose-micro/postman/internal/api/grpc"
+	"github.com/ose-micro/postman/internal/api/notifier"
+	"github.com/ose-micro/postman/internal/api/relay"
+	"github.com/ose-micro/postman/internal/api/webhook"
 	"github.com/ose-micro/postman/internal/app"
+	"github.com/ose-micro/postman/internal/app/email"
 	"github.com/ose-micro/postman/internal/business"
+	"github.com/ose-micro/postman/internal/infrastructure/delivery"
+	"github.com/ose-micro/postman/internal/infrastructure/ratelimit"
 	"github.com/ose-micro/postman/internal/infrastructure/repository"
 	"go.uber.org/fx"
 )
@@ -26,22 +34,38 @@ func main() {
 			postgres.New,
 			mongodb.New,
 			mailer.New,
+			ratelimit.New,
+			delivery.New,
 			app.InjectApps,
 			nats.New,
 			repository.InjectRepository,
 		),
 		fx.Invoke(bus.InvokeConsumers),
 		fx.Invoke(grpc.RunGRPCServer),
+		fx.Invoke(dispatcher.InvokeDispatcher),
+		fx.Invoke(bounce.InvokeBounce),
+		fx.Invoke(webhook.InvokeWebhook),
+		fx.Invoke(notifier.InvokeNotifier),
+		fx.Invoke(relay.InvokeRelay),
 	).Run()
 }
 
 func loadConfig() (config.Service, logger.Config, tracing.Config, timestamp.Config,
-	*postgres.Config, mongodb.Config, nats.Config, grpc.Config, *mailer.Config, error) {
+	*postgres.Config, mongodb.Config, nats.Config, grpc.Config, *mailer.Config, dispatcher.Config, email.Config, delivery.Config, ratelimit.Config, bounce.Config, webhook.Config, notifier.Config, relay.Config, bus.Config, error) {
 	var grpcConfig grpc.Config
 	var natsConf nats.Config
 	var postgresConfig postgres.Config
 	var mongoConfig mongodb.Config
 	var mailerConfig mailer.Config
+	var dispatcherConfig dispatcher.Config
+	var emailConfig email.Config
+	var deliveryConfig delivery.Config
+	var ratelimitConfig ratelimit.Config
+	var bounceConfig bounce.Config
+	var webhookConfig webhook.Config
+	var notifierConfig notifier.Config
+	var relayConfig relay.Config
+	var consumerConfig bus.Config
 
 	conf, err := config.Load(
 		config.WithExtension("nats", &natsConf),
@@ -49,13 +73,22 @@ func loadConfig() (config.Service, logger.Config, tracing.Config, timestamp.Conf
 		config.WithExtension("mongo", &mongoConfig),
 		config.WithExtension("grpc", &grpcConfig),
 		config.WithExtension("mailer", &mailerConfig),
+		config.WithExtension("dispatcher", &dispatcherConfig),
+		config.WithExtension("email", &emailConfig),
+		config.WithExtension("delivery", &deliveryConfig),
+		config.WithExtension("ratelimit", &ratelimitConfig),
+		config.WithExtension("bounce", &bounceConfig),
+		config.WithExtension("webhook", &webhookConfig),
+		config.WithExtension("notifier", &notifierConfig),
+		config.WithExtension("relay", &relayConfig),
+		config.WithExtension("consumer", &consumerConfig),
 	)
 
 	if err != nil {
 		return config.Service{}, logger.Config{}, tracing.Config{}, timestamp.Config{},
-			nil, mongodb.Config{}, nats.Config{}, grpc.Config{}, nil, err
+			nil, mongodb.Config{}, nats.Config{}, grpc.Config{}, nil, dispatcher.Config{}, email.Config{}, delivery.Config{}, ratelimit.Config{}, bounce.Config{}, webhook.Config{}, notifier.Config{}, relay.Config{}, bus.Config{}, err
 	}
 
 	return conf.Core.Service, conf.Core.Service.Logger, conf.Core.Service.Tracer, conf.Core.Service.Timestamp,
-		&postgresConfig, mongoConfig, natsConf, grpcConfig, &mailerConfig, nil
+		&postgresConfig, mongoConfig, natsConf, grpcConfig, &mailerConfig, dispatcherConfig, emailConfig, deliveryConfig, ratelimitConfig, bounceConfig, webhookConfig, notifierConfig, relayConfig, consumerConfig, nil
 }
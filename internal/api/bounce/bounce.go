@@ -0,0 +1,147 @@
+package bounce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/postman/internal/app"
+	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/postman/internal/infrastructure/dsn"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// errUnparseable marks a message that fails the same way however often it is read.
+var errUnparseable = errors.New("unparseable bounce")
+
+type Config struct {
+	// Listen is the address of the SMTP listener bounces are relayed to, e.g.
+	// :2525. Empty disables it.
+	Listen string `mapstructure:"listen"`
+	// Directory is a maildir whose new messages are processed as bounces.
+	// Empty disables it.
+	Directory string `mapstructure:"directory"`
+	// Interval is how often the maildir is scanned.
+	Interval time.Duration `mapstructure:"interval"`
+	// MaxSize caps an accepted message in bytes.
+	MaxSize int64 `mapstructure:"max_size"`
+}
+
+const (
+	defaultInterval = 10 * time.Second
+	defaultMaxSize  = 10 << 20
+)
+
+func (c Config) withDefaults() Config {
+	if c.Interval <= 0 {
+		c.Interval = defaultInterval
+	}
+
+	if c.MaxSize <= 0 {
+		c.MaxSize = defaultMaxSize
+	}
+
+	return c
+}
+
+func InvokeBounce(lc fx.Lifecycle, conf Config, apps app.Apps, log logger.Logger) {
+	conf = conf.withDefaults()
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	var listener net.Listener
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			if conf.Listen != "" {
+				var err error
+				if listener, err = net.Listen("tcp", conf.Listen); err != nil {
+					return err
+				}
+
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					serve(ctx, listener, conf, apps.Email, log)
+				}()
+
+				log.Info("bounce listener started", zap.String("address", conf.Listen))
+			}
+
+			if conf.Directory != "" {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					watch(ctx, conf, apps.Email, log)
+				}()
+
+				log.Info("bounce maildir watched", zap.String("directory", conf.Directory))
+			}
+
+			return nil
+		},
+		OnStop: func(stop context.Context) error {
+			cancel()
+			if listener != nil {
+				_ = listener.Close()
+			}
+
+			done := make(chan struct{})
+			go func() {
+				wg.Wait()
+				close(done)
+			}()
+
+			select {
+			case <-done:
+			case <-stop.Done():
+				log.Error("bounce processor stopped before draining")
+			}
+
+			return nil
+		},
+	})
+}
+
+// process applies every failed recipient of a notification. Messages that are
+// not notifications are dropped with dsn.ErrNotReport.
+func process(ctx context.Context, message io.Reader, app email.App, log logger.Logger) error {
+	report, err := dsn.Parse(message)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errUnparseable, err)
+	}
+
+	if report.MessageId == "" {
+		log.Info("bounce without original message id ignored",
+			zap.String("operation", "bounce"),
+			zap.String("reporting_mta", report.ReportingMTA),
+		)
+
+		return nil
+	}
+
+	for _, recipient := range report.Recipients {
+		if !recipient.Failed() {
+			continue
+		}
+
+		if _, err := app.Event(ctx, email.EventCommand{
+			Type:       email.EventBounced,
+			MessageId:  report.MessageId,
+			Recipient:  recipient.Address,
+			Status:     recipient.Status,
+			Diagnostic: recipient.Diagnostic,
+			Permanent:  recipient.Permanent(),
+			Source:     "dsn",
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
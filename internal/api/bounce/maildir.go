@@ -0,0 +1,99 @@
+package bounce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/postman/internal/business/email"
+	"go.uber.org/zap"
+)
+
+// watch scans the maildir until ctx ends. Processed messages move to cur with
+// the seen flag, and ones that cannot be parsed are flagged there for an
+// operator to inspect. Any other failure leaves the message in new for the
+// next scan.
+func watch(ctx context.Context, conf Config, app email.App, log logger.Logger) {
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		if err := os.MkdirAll(filepath.Join(conf.Directory, sub), 0o700); err != nil {
+			log.Error("failed to prepare bounce maildir", zap.Error(err))
+			return
+		}
+	}
+
+	ticker := time.NewTicker(conf.Interval)
+	defer ticker.Stop()
+
+	for {
+		scan(ctx, conf, app, log)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func scan(ctx context.Context, conf Config, app email.App, log logger.Logger) {
+	entries, err := os.ReadDir(filepath.Join(conf.Directory, "new"))
+	if err != nil {
+		log.Error("failed to read bounce maildir", zap.Error(err))
+		return
+	}
+
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if entry.IsDir() {
+			continue
+		}
+
+		flag := "S"
+		if err := processFile(ctx, filepath.Join(conf.Directory, "new", entry.Name()), conf, app, log); err != nil {
+			log.Error("failed to process bounce",
+				zap.String("operation", "bounce"),
+				zap.String("file", entry.Name()),
+				zap.Bool("retry", !errors.Is(err, errUnparseable)),
+				zap.Error(err),
+			)
+
+			if !errors.Is(err, errUnparseable) {
+				continue
+			}
+			flag = "F"
+		}
+
+		if err := os.Rename(
+			filepath.Join(conf.Directory, "new", entry.Name()),
+			filepath.Join(conf.Directory, "cur", entry.Name()+":2,"+flag),
+		); err != nil {
+			log.Error("failed to move bounce", zap.String("file", entry.Name()), zap.Error(err))
+		}
+	}
+}
+
+func processFile(ctx context.Context, path string, conf Config, app email.App, log logger.Logger) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	if info.Size() > conf.MaxSize {
+		return fmt.Errorf("%w: message too large", errUnparseable)
+	}
+
+	return process(ctx, file, app, log)
+}
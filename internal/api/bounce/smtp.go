@@ -0,0 +1,128 @@
+package bounce
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/postman/internal/infrastructure/dsn"
+	"go.uber.org/zap"
+)
+
+// sessionTimeout bounds how long a client may stay idle between commands.
+const sessionTimeout = 5 * time.Minute
+
+// serve accepts connections until the listener is closed.
+func serve(ctx context.Context, listener net.Listener, conf Config, app email.App, log logger.Logger) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, net.ErrClosed) {
+				return
+			}
+
+			log.Error("failed to accept bounce connection", zap.Error(err))
+			continue
+		}
+
+		go session(ctx, conn, conf, app, log)
+	}
+}
+
+// session speaks just enough SMTP for an MTA to relay bounces to us. Every
+// message is accepted once stored. One that cannot be parsed is rejected for
+// good with 554, while a failure to apply it gets 451 so the relay tries again
+// later.
+func session(ctx context.Context, conn net.Conn, conf Config, app email.App, log logger.Logger) {
+	defer conn.Close()
+
+	text := textproto.NewConn(conn)
+	reply := func(code int, message string) bool {
+		return text.PrintfLine("%d %s", code, message) == nil
+	}
+
+	if !reply(220, "postman bounce processor ready") {
+		return
+	}
+
+	mail := false
+	for {
+		_ = conn.SetDeadline(time.Now().Add(sessionTimeout))
+
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+
+		verb, _, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "HELO", "EHLO":
+			mail = false
+			reply(250, "postman")
+		case "MAIL":
+			mail = true
+			reply(250, "ok")
+		case "RCPT":
+			if !mail {
+				reply(503, "need MAIL first")
+				continue
+			}
+			reply(250, "ok")
+		case "DATA":
+			if !mail {
+				reply(503, "need MAIL first")
+				continue
+			}
+			mail = false
+
+			reply(354, "end data with <CR><LF>.<CR><LF>")
+			dot := text.DotReader()
+			message, err := io.ReadAll(io.LimitReader(dot, conf.MaxSize+1))
+			if err != nil {
+				return
+			}
+
+			if int64(len(message)) > conf.MaxSize {
+				// drain the rest so the session stays in sync
+				_, _ = io.Copy(io.Discard, dot)
+				reply(552, "message too large")
+				continue
+			}
+
+			if err := process(ctx, bytes.NewReader(message), app, log); err != nil {
+				switch {
+				case errors.Is(err, dsn.ErrNotReport):
+					log.Info("non bounce message dropped", zap.String("operation", "bounce"))
+				case errors.Is(err, errUnparseable):
+					// it would fail the same way on every retry
+					log.Error("unparseable bounce rejected", zap.String("operation", "bounce"), zap.Error(err))
+					reply(554, "unparseable delivery status notification")
+					continue
+				default:
+					log.Error("failed to process bounce", zap.String("operation", "bounce"), zap.Error(err))
+					reply(451, "try again later")
+					continue
+				}
+			}
+
+			reply(250, "ok")
+		case "RSET":
+			mail = false
+			reply(250, "ok")
+		case "NOOP":
+			reply(250, "ok")
+		case "QUIT":
+			reply(221, "bye")
+			return
+		default:
+			reply(502, "command not implemented")
+		}
+	}
+}
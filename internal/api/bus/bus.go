@@ -8,38 +8,66 @@ import (
 	"github.com/ose-micro/core/domain"
 	"github.com/ose-micro/core/logger"
 	"github.com/ose-micro/postman/internal/app"
+	"github.com/ose-micro/postman/internal/business/deadletter"
 	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/postman/internal/business/event"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 )
 
-func InvokeConsumers(lc fx.Lifecycle, app app.Apps, log logger.Logger, bus domain.Bus) {
+type Config struct {
+	// MaxDeliver bounds deliveries of a message that keeps failing transiently
+	// before it is dead lettered. Poison messages are dead lettered at once.
+	MaxDeliver int32 `mapstructure:"max_deliver"`
+}
+
+const defaultMaxDeliver = 5
+
+func (c Config) withDefaults() Config {
+	if c.MaxDeliver <= 0 {
+		c.MaxDeliver = defaultMaxDeliver
+	}
+
+	return c
+}
+
+func InvokeConsumers(lc fx.Lifecycle, conf Config, app app.Apps, log logger.Logger, bus domain.Bus) {
+	conf = conf.withDefaults()
+
 	lc.Append(fx.Hook{
 		OnStart: func(context.Context) error {
 			go func() {
-				eventList := []string{email.SendMailEvent}
-				err := bus.EnsureStream("POSTMAN", eventList...)
+				eventList := append([]string{email.SendMailEvent}, event.Subjects()...)
+				err := bus.EnsureStream(event.Stream, eventList...)
 				if err != nil {
 					log.Fatal("nats stream failed", zap.Error(err))
 				}
 
-				newEmailConsumer(app.Email, bus, log)
+				err = bus.EnsureStream(deadletter.Stream, deadletter.Topic(email.SendMailEvent))
+				if err != nil {
+					log.Fatal("nats dead letter stream failed", zap.Error(err))
+				}
+
+				newEmailConsumer(app, conf, bus, log)
 			}()
 			return nil
 		},
 	})
 }
 
+// toByte returns the JSON of a message. The bus hands over decoded JSON, so
+// anything it decodes to, not only objects, is encoded back.
 func toByte(data interface{}) ([]byte, error) {
-	mapData, ok := data.(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid message format")
+	switch raw := data.(type) {
+	case []byte:
+		return raw, nil
+	case json.RawMessage:
+		return raw, nil
 	}
 
-	// Marshal it to JSON
-	raw, err := json.Marshal(mapData)
+	raw, err := json.Marshal(data)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal map: %w", err)
+		return nil, fmt.Errorf("failed to marshal message: %w", err)
 	}
 
 	return raw, nil
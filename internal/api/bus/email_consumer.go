@@ -4,34 +4,103 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 
 	"github.com/ose-micro/core/domain"
 	"github.com/ose-micro/core/logger"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/nats"
+	"github.com/ose-micro/postman/internal/app"
+	"github.com/ose-micro/postman/internal/business/deadletter"
 	"github.com/ose-micro/postman/internal/business/email"
+	"go.uber.org/zap"
 )
 
-func newEmailConsumer(app email.App, bus domain.Bus, log logger.Logger) {
+func newEmailConsumer(apps app.Apps, conf Config, bus domain.Bus, log logger.Logger) {
 	// Send Event
 	_ = bus.Subscribe(email.SendMailEvent, "email_consumer", "send_mail_consumer", func(ctx context.Context, data any) error {
-		var event email.SendCommand
 		raw, err := toByte(data)
 		if err != nil {
-			return err
+			raw = []byte(fmt.Sprintf("%v", data))
+			err = ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error())
+		} else {
+			err = send(ctx, apps.Email, raw)
 		}
 
-		if err := json.Unmarshal(raw, &event); err != nil {
-			return fmt.Errorf("failed to unmarshal into SendMailEvent: %w", err)
-		}
-		if _, err = app.Create(ctx, email.CreateCommand{
-			Recipient: event.Recipient,
-			Sender:    event.Sender,
-			Data:      event.Data,
-			Template:  event.Template,
-			From:      event.From,
-		}); err != nil {
-			return err
-		}
+		return settle(ctx, apps.DeadLetter, conf, email.SendMailEvent, raw, err, log)
+	})
+}
+
+func send(ctx context.Context, app email.App, raw []byte) error {
+	var event email.SendCommand
+	if err := json.Unmarshal(raw, &event); err != nil {
+		err = fmt.Errorf("failed to unmarshal into SendMailEvent: %w", err)
+		return ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error())
+	}
+
+	_, err := app.Create(ctx, email.CreateCommand{
+		Recipient:      event.Recipient,
+		To:             event.To,
+		Cc:             event.Cc,
+		Bcc:            event.Bcc,
+		ReplyTo:        event.ReplyTo,
+		Sender:         event.Sender,
+		Data:           event.Data,
+		Template:       event.Template,
+		From:           event.From,
+		SendAt:         event.SendAt,
+		IdempotencyKey: event.IdempotencyKey,
+		Attachments:    event.Attachments,
+		Headers:        event.Headers,
+		Tags:           event.Tags,
+		Category:       event.Category,
+	})
+
+	return err
+}
 
+// settle tells the bus what to do with a handled message: acknowledge it once
+// it succeeded or was dead lettered, and have it delivered again otherwise.
+// JetStream counts the deliveries, so a message is given up on after
+// MaxDeliver of them however many instances it bounced between.
+func settle(ctx context.Context, app deadletter.App, conf Config, subject string, raw []byte,
+	failure error, log logger.Logger) error {
+	if failure == nil {
 		return nil
+	}
+
+	letter, err := app.Settle(ctx, deadletter.SettleCommand{
+		Subject:    subject,
+		Payload:    string(raw),
+		Failure:    failure,
+		Delivered:  delivered(ctx, conf.MaxDeliver),
+		MaxDeliver: conf.MaxDeliver,
 	})
+	if err != nil {
+		log.Error("failed to settle message",
+			zap.String("operation", "settle"),
+			zap.String("subject", subject),
+			zap.Error(err),
+		)
+	}
+
+	if letter != nil {
+		return nil
+	}
+
+	// without a letter the message must not be acknowledged, or it is lost
+	return failure
+}
+
+// delivered is how many times JetStream has delivered the message being
+// handled, this delivery included, as its metadata reports. A message without
+// metadata did not come from a JetStream consumer and is never redelivered, so
+// it counts as the last delivery and is dead lettered rather than lost.
+func delivered(ctx context.Context, maxDeliver int32) int32 {
+	metadata, ok := nats.Metadata(ctx)
+	if !ok || metadata.NumDelivered == 0 {
+		return maxDeliver
+	}
+
+	return int32(min(metadata.NumDelivered, math.MaxInt32))
 }
@@ -0,0 +1,13 @@
+package bus
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDeliveredWithoutMetadata(t *testing.T) {
+	// nothing would deliver the message again, so it must count as exhausted
+	if got := delivered(context.Background(), 5); got != 5 {
+		t.Fatalf("delivered = %d, want 5", got)
+	}
+}
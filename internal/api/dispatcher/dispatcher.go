@@ -0,0 +1,179 @@
+package dispatcher
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/postman/internal/app"
+	"github.com/ose-micro/postman/internal/business/email"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Config struct {
+	// Workers is the number of emails delivered concurrently.
+	Workers int `mapstructure:"workers"`
+	// Lease is how long a claimed email is hidden from other workers. A send
+	// is cut off after three quarters of it, and a worker that loses its lease
+	// cannot save over the worker that reclaimed the email.
+	Lease time.Duration `mapstructure:"lease"`
+	// Interval is how long an idle worker waits before polling the queue again.
+	Interval time.Duration `mapstructure:"interval"`
+	// ScheduleInterval is how often scheduled emails that have come due are queued.
+	ScheduleInterval time.Duration `mapstructure:"schedule_interval"`
+	// MaxAttempts bounds deliveries per email before it is marked failed.
+	MaxAttempts int32 `mapstructure:"max_attempts"`
+	// BaseDelay is the wait after the first transient failure; it doubles per attempt up to MaxDelay.
+	BaseDelay time.Duration `mapstructure:"base_delay"`
+	MaxDelay  time.Duration `mapstructure:"max_delay"`
+	// Jitter is the fraction, between 0 and 1, by which each delay is randomly shortened.
+	Jitter float64 `mapstructure:"jitter"`
+}
+
+const (
+	defaultWorkers  = 4
+	defaultLease    = time.Minute
+	defaultInterval = time.Second
+
+	defaultScheduleInterval = 5 * time.Second
+
+	defaultMaxAttempts = 5
+	defaultBaseDelay   = 30 * time.Second
+	defaultMaxDelay    = time.Hour
+	defaultJitter      = 0.2
+)
+
+func (c Config) withDefaults() Config {
+	if c.Workers <= 0 {
+		c.Workers = defaultWorkers
+	}
+
+	if c.Lease <= 0 {
+		c.Lease = defaultLease
+	}
+
+	if c.Interval <= 0 {
+		c.Interval = defaultInterval
+	}
+
+	if c.ScheduleInterval <= 0 {
+		c.ScheduleInterval = defaultScheduleInterval
+	}
+
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = defaultMaxAttempts
+	}
+
+	if c.BaseDelay <= 0 {
+		c.BaseDelay = defaultBaseDelay
+	}
+
+	if c.MaxDelay <= 0 {
+		c.MaxDelay = defaultMaxDelay
+	}
+
+	if c.Jitter <= 0 || c.Jitter > 1 {
+		c.Jitter = defaultJitter
+	}
+
+	return c
+}
+
+func InvokeDispatcher(lc fx.Lifecycle, conf Config, apps app.Apps, log logger.Logger) {
+	conf = conf.withDefaults()
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			for i := 0; i < conf.Workers; i++ {
+				wg.Add(1)
+				go func(worker int) {
+					defer wg.Done()
+					work(ctx, worker, conf, apps.Email, log)
+				}(i)
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				schedule(ctx, conf, apps.Email, log)
+			}()
+
+			log.Info("dispatcher started", zap.Int("workers", conf.Workers))
+			return nil
+		},
+		OnStop: func(stop context.Context) error {
+			cancel()
+
+			done := make(chan struct{})
+			go func() {
+				wg.Wait()
+				close(done)
+			}()
+
+			select {
+			case <-done:
+				log.Info("dispatcher stopped")
+			case <-stop.Done():
+				log.Error("dispatcher stopped before workers drained")
+			}
+
+			return nil
+		},
+	})
+}
+
+// work drains the queue and only sleeps once it comes back empty or erroring.
+func work(ctx context.Context, worker int, conf Config, app email.App, log logger.Logger) {
+	for {
+		record, err := app.Dispatch(ctx, email.DispatchCommand{
+			Lease: conf.Lease,
+			Retry: email.RetryPolicy{
+				MaxAttempts: conf.MaxAttempts,
+				BaseDelay:   conf.BaseDelay,
+				MaxDelay:    conf.MaxDelay,
+				Jitter:      conf.Jitter,
+			},
+		})
+		if err != nil {
+			log.Error("dispatch failed", zap.Int("worker", worker), zap.Error(err))
+		}
+
+		if err == nil && record != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(conf.Interval):
+		}
+	}
+}
+
+// schedule releases due emails to the queue. Each email is released by its own
+// conditional update, so every instance may run it; claiming still hands each
+// email to one worker.
+func schedule(ctx context.Context, conf Config, app email.App, log logger.Logger) {
+	ticker := time.NewTicker(conf.ScheduleInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := app.Release(ctx, email.ReleaseCommand{At: time.Now()}); err != nil {
+			log.Error("release failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
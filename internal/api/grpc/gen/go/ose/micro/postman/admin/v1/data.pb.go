@@ -0,0 +1,976 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: ose/micro/postman/admin/v1/data.proto
+
+package adminv1
+
+import (
+	v1 "github.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeadLetterKind int32
+
+const (
+	DeadLetterKind_DeadLetterKindUnknown DeadLetterKind = 0
+	// The message can never succeed, such as a malformed payload or a missing template.
+	DeadLetterKind_DeadLetterKindPoison DeadLetterKind = 1
+	// The message kept failing transiently until it ran out of deliveries.
+	DeadLetterKind_DeadLetterKindExhausted DeadLetterKind = 2
+)
+
+// Enum value maps for DeadLetterKind.
+var (
+	DeadLetterKind_name = map[int32]string{
+		0: "DeadLetterKindUnknown",
+		1: "DeadLetterKindPoison",
+		2: "DeadLetterKindExhausted",
+	}
+	DeadLetterKind_value = map[string]int32{
+		"DeadLetterKindUnknown":   0,
+		"DeadLetterKindPoison":    1,
+		"DeadLetterKindExhausted": 2,
+	}
+)
+
+func (x DeadLetterKind) Enum() *DeadLetterKind {
+	p := new(DeadLetterKind)
+	*p = x
+	return p
+}
+
+func (x DeadLetterKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeadLetterKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_ose_micro_postman_admin_v1_data_proto_enumTypes[0].Descriptor()
+}
+
+func (DeadLetterKind) Type() protoreflect.EnumType {
+	return &file_ose_micro_postman_admin_v1_data_proto_enumTypes[0]
+}
+
+func (x DeadLetterKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeadLetterKind.Descriptor instead.
+func (DeadLetterKind) EnumDescriptor() ([]byte, []int) {
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP(), []int{0}
+}
+
+type DeadLetterState int32
+
+const (
+	DeadLetterState_DeadLetterStateUnknown  DeadLetterState = 0
+	DeadLetterState_DeadLetterStateDead     DeadLetterState = 1
+	DeadLetterState_DeadLetterStateReplayed DeadLetterState = 2
+)
+
+// Enum value maps for DeadLetterState.
+var (
+	DeadLetterState_name = map[int32]string{
+		0: "DeadLetterStateUnknown",
+		1: "DeadLetterStateDead",
+		2: "DeadLetterStateReplayed",
+	}
+	DeadLetterState_value = map[string]int32{
+		"DeadLetterStateUnknown":  0,
+		"DeadLetterStateDead":     1,
+		"DeadLetterStateReplayed": 2,
+	}
+)
+
+func (x DeadLetterState) Enum() *DeadLetterState {
+	p := new(DeadLetterState)
+	*p = x
+	return p
+}
+
+func (x DeadLetterState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeadLetterState) Descriptor() protoreflect.EnumDescriptor {
+	return file_ose_micro_postman_admin_v1_data_proto_enumTypes[1].Descriptor()
+}
+
+func (DeadLetterState) Type() protoreflect.EnumType {
+	return &file_ose_micro_postman_admin_v1_data_proto_enumTypes[1]
+}
+
+func (x DeadLetterState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeadLetterState.Descriptor instead.
+func (DeadLetterState) EnumDescriptor() ([]byte, []int) {
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP(), []int{1}
+}
+
+// RateLimit is the state of one token bucket on the answering instance.
+type RateLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// provider, domain or sender.
+	Scope string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Tokens added per second.
+	Rate  float64 `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Burst int32   `protobuf:"varint,4,opt,name=burst,proto3" json:"burst,omitempty"`
+	// Tokens available now; below one means sends are being queued.
+	Tokens float64 `protobuf:"fixed64,5,opt,name=tokens,proto3" json:"tokens,omitempty"`
+	// Sends held back by this bucket since start up.
+	Throttled     int64                  `protobuf:"varint,6,opt,name=throttled,proto3" json:"throttled,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit) ProtoMessage() {}
+
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RateLimit) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *RateLimit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RateLimit) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *RateLimit) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *RateLimit) GetTokens() float64 {
+	if x != nil {
+		return x.Tokens
+	}
+	return 0
+}
+
+func (x *RateLimit) GetThrottled() int64 {
+	if x != nil {
+		return x.Throttled
+	}
+	return 0
+}
+
+func (x *RateLimit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type RateLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitsRequest) Reset() {
+	*x = RateLimitsRequest{}
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitsRequest) ProtoMessage() {}
+
+func (x *RateLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitsRequest.ProtoReflect.Descriptor instead.
+func (*RateLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP(), []int{1}
+}
+
+type RateLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*RateLimit           `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitsResponse) Reset() {
+	*x = RateLimitsResponse{}
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitsResponse) ProtoMessage() {}
+
+func (x *RateLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitsResponse.ProtoReflect.Descriptor instead.
+func (*RateLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RateLimitsResponse) GetData() []*RateLimit {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// DeadLetter is a message a consumer gave up on.
+type DeadLetter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Subject the message was consumed from and is replayed to.
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// The message as it was received.
+	Payload       string                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Kind          DeadLetterKind         `protobuf:"varint,5,opt,name=kind,proto3,enum=ose.micro.postman.admin.v1.DeadLetterKind" json:"kind,omitempty"`
+	Attempts      int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	State         DeadLetterState        `protobuf:"varint,7,opt,name=state,proto3,enum=ose.micro.postman.admin.v1.DeadLetterState" json:"state,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReplayedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=replayed_at,json=replayedAt,proto3" json:"replayed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeadLetter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetKind() DeadLetterKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DeadLetterKind_DeadLetterKindUnknown
+}
+
+func (x *DeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetState() DeadLetterState {
+	if x != nil {
+		return x.State
+	}
+	return DeadLetterState_DeadLetterStateUnknown
+}
+
+func (x *DeadLetter) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetReplayedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReplayedAt
+	}
+	return nil
+}
+
+type DeadLetters struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*DeadLetter          `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetters) Reset() {
+	*x = DeadLetters{}
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetters) ProtoMessage() {}
+
+func (x *DeadLetters) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetters.ProtoReflect.Descriptor instead.
+func (*DeadLetters) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeadLetters) GetData() []*DeadLetter {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *v1.Request            `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLettersRequest) Reset() {
+	*x = DeadLettersRequest{}
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLettersRequest) ProtoMessage() {}
+
+func (x *DeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*DeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeadLettersRequest) GetRequest() *v1.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type DeadLettersResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Result        map[string]*DeadLetters `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLettersResponse) Reset() {
+	*x = DeadLettersResponse{}
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLettersResponse) ProtoMessage() {}
+
+func (x *DeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*DeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeadLettersResponse) GetResult() map[string]*DeadLetters {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DeadLetterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterRequest) Reset() {
+	*x = DeadLetterRequest{}
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterRequest) ProtoMessage() {}
+
+func (x *DeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*DeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeadLetterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeadLetterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        *DeadLetter            `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeadLetterResponse) Reset() {
+	*x = DeadLetterResponse{}
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeadLetterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterResponse) ProtoMessage() {}
+
+func (x *DeadLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterResponse.ProtoReflect.Descriptor instead.
+func (*DeadLetterResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeadLetterResponse) GetRecord() *DeadLetter {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type ReplayDeadLetterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLetterRequest) Reset() {
+	*x = ReplayDeadLetterRequest{}
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetterRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReplayDeadLetterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReplayDeadLetterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Record        *DeadLetter            `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayDeadLetterResponse) Reset() {
+	*x = ReplayDeadLetterResponse{}
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayDeadLetterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetterResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetterResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReplayDeadLetterResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReplayDeadLetterResponse) GetRecord() *DeadLetter {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+// PurgeDeadLettersRequest names one dead letter by id, or all of them.
+type PurgeDeadLettersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	All           bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDeadLettersRequest) Reset() {
+	*x = PurgeDeadLettersRequest{}
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeadLettersRequest) ProtoMessage() {}
+
+func (x *PurgeDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PurgeDeadLettersRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PurgeDeadLettersRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type PurgeDeadLettersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Purged        int64                  `protobuf:"varint,2,opt,name=purged,proto3" json:"purged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeDeadLettersResponse) Reset() {
+	*x = PurgeDeadLettersResponse{}
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeadLettersResponse) ProtoMessage() {}
+
+func (x *PurgeDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_admin_v1_data_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PurgeDeadLettersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PurgeDeadLettersResponse) GetPurged() int64 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+var File_ose_micro_postman_admin_v1_data_proto protoreflect.FileDescriptor
+
+const file_ose_micro_postman_admin_v1_data_proto_rawDesc = "" +
+	"\n" +
+	"%ose/micro/postman/admin/v1/data.proto\x12\x1aose.micro.postman.admin.v1\x1a!ose/micro/common/v1/request.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd0\x01\n" +
+	"\tRateLimit\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\x01R\x04rate\x12\x14\n" +
+	"\x05burst\x18\x04 \x01(\x05R\x05burst\x12\x16\n" +
+	"\x06tokens\x18\x05 \x01(\x01R\x06tokens\x12\x1c\n" +
+	"\tthrottled\x18\x06 \x01(\x03R\tthrottled\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x13\n" +
+	"\x11RateLimitsRequest\"O\n" +
+	"\x12RateLimitsResponse\x129\n" +
+	"\x04data\x18\x01 \x03(\v2%.ose.micro.postman.admin.v1.RateLimitR\x04data\"\xff\x02\n" +
+	"\n" +
+	"DeadLetter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\tR\apayload\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12>\n" +
+	"\x04kind\x18\x05 \x01(\x0e2*.ose.micro.postman.admin.v1.DeadLetterKindR\x04kind\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12A\n" +
+	"\x05state\x18\a \x01(\x0e2+.ose.micro.postman.admin.v1.DeadLetterStateR\x05state\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vreplayed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"replayedAt\"I\n" +
+	"\vDeadLetters\x12:\n" +
+	"\x04data\x18\x01 \x03(\v2&.ose.micro.postman.admin.v1.DeadLetterR\x04data\"L\n" +
+	"\x12DeadLettersRequest\x126\n" +
+	"\arequest\x18\x01 \x01(\v2\x1c.ose.micro.common.v1.RequestR\arequest\"\xce\x01\n" +
+	"\x13DeadLettersResponse\x12S\n" +
+	"\x06result\x18\x01 \x03(\v2;.ose.micro.postman.admin.v1.DeadLettersResponse.ResultEntryR\x06result\x1ab\n" +
+	"\vResultEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12=\n" +
+	"\x05value\x18\x02 \x01(\v2'.ose.micro.postman.admin.v1.DeadLettersR\x05value:\x028\x01\"#\n" +
+	"\x11DeadLetterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"T\n" +
+	"\x12DeadLetterResponse\x12>\n" +
+	"\x06record\x18\x01 \x01(\v2&.ose.micro.postman.admin.v1.DeadLetterR\x06record\")\n" +
+	"\x17ReplayDeadLetterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"t\n" +
+	"\x18ReplayDeadLetterResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12>\n" +
+	"\x06record\x18\x02 \x01(\v2&.ose.micro.postman.admin.v1.DeadLetterR\x06record\";\n" +
+	"\x17PurgeDeadLettersRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\"L\n" +
+	"\x18PurgeDeadLettersResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x16\n" +
+	"\x06purged\x18\x02 \x01(\x03R\x06purged*b\n" +
+	"\x0eDeadLetterKind\x12\x19\n" +
+	"\x15DeadLetterKindUnknown\x10\x00\x12\x18\n" +
+	"\x14DeadLetterKindPoison\x10\x01\x12\x1b\n" +
+	"\x17DeadLetterKindExhausted\x10\x02*c\n" +
+	"\x0fDeadLetterState\x12\x1a\n" +
+	"\x16DeadLetterStateUnknown\x10\x00\x12\x17\n" +
+	"\x13DeadLetterStateDead\x10\x01\x12\x1b\n" +
+	"\x17DeadLetterStateReplayed\x10\x02B\x92\x02\n" +
+	"\x1ecom.ose.micro.postman.admin.v1B\tDataProtoP\x01ZXgithub.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/admin/v1;adminv1\xa2\x02\x04OMPA\xaa\x02\x1aOse.Micro.Postman.Admin.V1\xca\x02\x1aOse\\Micro\\Postman\\Admin\\V1\xe2\x02&Ose\\Micro\\Postman\\Admin\\V1\\GPBMetadata\xea\x02\x1eOse::Micro::Postman::Admin::V1b\x06proto3"
+
+var (
+	file_ose_micro_postman_admin_v1_data_proto_rawDescOnce sync.Once
+	file_ose_micro_postman_admin_v1_data_proto_rawDescData []byte
+)
+
+func file_ose_micro_postman_admin_v1_data_proto_rawDescGZIP() []byte {
+	file_ose_micro_postman_admin_v1_data_proto_rawDescOnce.Do(func() {
+		file_ose_micro_postman_admin_v1_data_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ose_micro_postman_admin_v1_data_proto_rawDesc), len(file_ose_micro_postman_admin_v1_data_proto_rawDesc)))
+	})
+	return file_ose_micro_postman_admin_v1_data_proto_rawDescData
+}
+
+var file_ose_micro_postman_admin_v1_data_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ose_micro_postman_admin_v1_data_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_ose_micro_postman_admin_v1_data_proto_goTypes = []any{
+	(DeadLetterKind)(0),              // 0: ose.micro.postman.admin.v1.DeadLetterKind
+	(DeadLetterState)(0),             // 1: ose.micro.postman.admin.v1.DeadLetterState
+	(*RateLimit)(nil),                // 2: ose.micro.postman.admin.v1.RateLimit
+	(*RateLimitsRequest)(nil),        // 3: ose.micro.postman.admin.v1.RateLimitsRequest
+	(*RateLimitsResponse)(nil),       // 4: ose.micro.postman.admin.v1.RateLimitsResponse
+	(*DeadLetter)(nil),               // 5: ose.micro.postman.admin.v1.DeadLetter
+	(*DeadLetters)(nil),              // 6: ose.micro.postman.admin.v1.DeadLetters
+	(*DeadLettersRequest)(nil),       // 7: ose.micro.postman.admin.v1.DeadLettersRequest
+	(*DeadLettersResponse)(nil),      // 8: ose.micro.postman.admin.v1.DeadLettersResponse
+	(*DeadLetterRequest)(nil),        // 9: ose.micro.postman.admin.v1.DeadLetterRequest
+	(*DeadLetterResponse)(nil),       // 10: ose.micro.postman.admin.v1.DeadLetterResponse
+	(*ReplayDeadLetterRequest)(nil),  // 11: ose.micro.postman.admin.v1.ReplayDeadLetterRequest
+	(*ReplayDeadLetterResponse)(nil), // 12: ose.micro.postman.admin.v1.ReplayDeadLetterResponse
+	(*PurgeDeadLettersRequest)(nil),  // 13: ose.micro.postman.admin.v1.PurgeDeadLettersRequest
+	(*PurgeDeadLettersResponse)(nil), // 14: ose.micro.postman.admin.v1.PurgeDeadLettersResponse
+	nil,                              // 15: ose.micro.postman.admin.v1.DeadLettersResponse.ResultEntry
+	(*timestamppb.Timestamp)(nil),    // 16: google.protobuf.Timestamp
+	(*v1.Request)(nil),               // 17: ose.micro.common.v1.Request
+}
+var file_ose_micro_postman_admin_v1_data_proto_depIdxs = []int32{
+	16, // 0: ose.micro.postman.admin.v1.RateLimit.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 1: ose.micro.postman.admin.v1.RateLimitsResponse.data:type_name -> ose.micro.postman.admin.v1.RateLimit
+	0,  // 2: ose.micro.postman.admin.v1.DeadLetter.kind:type_name -> ose.micro.postman.admin.v1.DeadLetterKind
+	1,  // 3: ose.micro.postman.admin.v1.DeadLetter.state:type_name -> ose.micro.postman.admin.v1.DeadLetterState
+	16, // 4: ose.micro.postman.admin.v1.DeadLetter.created_at:type_name -> google.protobuf.Timestamp
+	16, // 5: ose.micro.postman.admin.v1.DeadLetter.replayed_at:type_name -> google.protobuf.Timestamp
+	5,  // 6: ose.micro.postman.admin.v1.DeadLetters.data:type_name -> ose.micro.postman.admin.v1.DeadLetter
+	17, // 7: ose.micro.postman.admin.v1.DeadLettersRequest.request:type_name -> ose.micro.common.v1.Request
+	15, // 8: ose.micro.postman.admin.v1.DeadLettersResponse.result:type_name -> ose.micro.postman.admin.v1.DeadLettersResponse.ResultEntry
+	5,  // 9: ose.micro.postman.admin.v1.DeadLetterResponse.record:type_name -> ose.micro.postman.admin.v1.DeadLetter
+	5,  // 10: ose.micro.postman.admin.v1.ReplayDeadLetterResponse.record:type_name -> ose.micro.postman.admin.v1.DeadLetter
+	6,  // 11: ose.micro.postman.admin.v1.DeadLettersResponse.ResultEntry.value:type_name -> ose.micro.postman.admin.v1.DeadLetters
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_ose_micro_postman_admin_v1_data_proto_init() }
+func file_ose_micro_postman_admin_v1_data_proto_init() {
+	if File_ose_micro_postman_admin_v1_data_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ose_micro_postman_admin_v1_data_proto_rawDesc), len(file_ose_micro_postman_admin_v1_data_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ose_micro_postman_admin_v1_data_proto_goTypes,
+		DependencyIndexes: file_ose_micro_postman_admin_v1_data_proto_depIdxs,
+		EnumInfos:         file_ose_micro_postman_admin_v1_data_proto_enumTypes,
+		MessageInfos:      file_ose_micro_postman_admin_v1_data_proto_msgTypes,
+	}.Build()
+	File_ose_micro_postman_admin_v1_data_proto = out.File
+	file_ose_micro_postman_admin_v1_data_proto_goTypes = nil
+	file_ose_micro_postman_admin_v1_data_proto_depIdxs = nil
+}
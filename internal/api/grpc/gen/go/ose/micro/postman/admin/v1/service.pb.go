@@ -0,0 +1,90 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: ose/micro/postman/admin/v1/service.proto
+
+package adminv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_ose_micro_postman_admin_v1_service_proto protoreflect.FileDescriptor
+
+const file_ose_micro_postman_admin_v1_service_proto_rawDesc = "" +
+	"\n" +
+	"(ose/micro/postman/admin/v1/service.proto\x12\x1aose.micro.postman.admin.v1\x1a%ose/micro/postman/admin/v1/data.proto2\xd6\x04\n" +
+	"\fAdminService\x12k\n" +
+	"\n" +
+	"RateLimits\x12-.ose.micro.postman.admin.v1.RateLimitsRequest\x1a..ose.micro.postman.admin.v1.RateLimitsResponse\x12n\n" +
+	"\vDeadLetters\x12..ose.micro.postman.admin.v1.DeadLettersRequest\x1a/.ose.micro.postman.admin.v1.DeadLettersResponse\x12k\n" +
+	"\n" +
+	"DeadLetter\x12-.ose.micro.postman.admin.v1.DeadLetterRequest\x1a..ose.micro.postman.admin.v1.DeadLetterResponse\x12}\n" +
+	"\x10ReplayDeadLetter\x123.ose.micro.postman.admin.v1.ReplayDeadLetterRequest\x1a4.ose.micro.postman.admin.v1.ReplayDeadLetterResponse\x12}\n" +
+	"\x10PurgeDeadLetters\x123.ose.micro.postman.admin.v1.PurgeDeadLettersRequest\x1a4.ose.micro.postman.admin.v1.PurgeDeadLettersResponseB\x95\x02\n" +
+	"\x1ecom.ose.micro.postman.admin.v1B\fServiceProtoP\x01ZXgithub.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/admin/v1;adminv1\xa2\x02\x04OMPA\xaa\x02\x1aOse.Micro.Postman.Admin.V1\xca\x02\x1aOse\\Micro\\Postman\\Admin\\V1\xe2\x02&Ose\\Micro\\Postman\\Admin\\V1\\GPBMetadata\xea\x02\x1eOse::Micro::Postman::Admin::V1b\x06proto3"
+
+var file_ose_micro_postman_admin_v1_service_proto_goTypes = []any{
+	(*RateLimitsRequest)(nil),        // 0: ose.micro.postman.admin.v1.RateLimitsRequest
+	(*DeadLettersRequest)(nil),       // 1: ose.micro.postman.admin.v1.DeadLettersRequest
+	(*DeadLetterRequest)(nil),        // 2: ose.micro.postman.admin.v1.DeadLetterRequest
+	(*ReplayDeadLetterRequest)(nil),  // 3: ose.micro.postman.admin.v1.ReplayDeadLetterRequest
+	(*PurgeDeadLettersRequest)(nil),  // 4: ose.micro.postman.admin.v1.PurgeDeadLettersRequest
+	(*RateLimitsResponse)(nil),       // 5: ose.micro.postman.admin.v1.RateLimitsResponse
+	(*DeadLettersResponse)(nil),      // 6: ose.micro.postman.admin.v1.DeadLettersResponse
+	(*DeadLetterResponse)(nil),       // 7: ose.micro.postman.admin.v1.DeadLetterResponse
+	(*ReplayDeadLetterResponse)(nil), // 8: ose.micro.postman.admin.v1.ReplayDeadLetterResponse
+	(*PurgeDeadLettersResponse)(nil), // 9: ose.micro.postman.admin.v1.PurgeDeadLettersResponse
+}
+var file_ose_micro_postman_admin_v1_service_proto_depIdxs = []int32{
+	0, // 0: ose.micro.postman.admin.v1.AdminService.RateLimits:input_type -> ose.micro.postman.admin.v1.RateLimitsRequest
+	1, // 1: ose.micro.postman.admin.v1.AdminService.DeadLetters:input_type -> ose.micro.postman.admin.v1.DeadLettersRequest
+	2, // 2: ose.micro.postman.admin.v1.AdminService.DeadLetter:input_type -> ose.micro.postman.admin.v1.DeadLetterRequest
+	3, // 3: ose.micro.postman.admin.v1.AdminService.ReplayDeadLetter:input_type -> ose.micro.postman.admin.v1.ReplayDeadLetterRequest
+	4, // 4: ose.micro.postman.admin.v1.AdminService.PurgeDeadLetters:input_type -> ose.micro.postman.admin.v1.PurgeDeadLettersRequest
+	5, // 5: ose.micro.postman.admin.v1.AdminService.RateLimits:output_type -> ose.micro.postman.admin.v1.RateLimitsResponse
+	6, // 6: ose.micro.postman.admin.v1.AdminService.DeadLetters:output_type -> ose.micro.postman.admin.v1.DeadLettersResponse
+	7, // 7: ose.micro.postman.admin.v1.AdminService.DeadLetter:output_type -> ose.micro.postman.admin.v1.DeadLetterResponse
+	8, // 8: ose.micro.postman.admin.v1.AdminService.ReplayDeadLetter:output_type -> ose.micro.postman.admin.v1.ReplayDeadLetterResponse
+	9, // 9: ose.micro.postman.admin.v1.AdminService.PurgeDeadLetters:output_type -> ose.micro.postman.admin.v1.PurgeDeadLettersResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_ose_micro_postman_admin_v1_service_proto_init() }
+func file_ose_micro_postman_admin_v1_service_proto_init() {
+	if File_ose_micro_postman_admin_v1_service_proto != nil {
+		return
+	}
+	file_ose_micro_postman_admin_v1_data_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ose_micro_postman_admin_v1_service_proto_rawDesc), len(file_ose_micro_postman_admin_v1_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ose_micro_postman_admin_v1_service_proto_goTypes,
+		DependencyIndexes: file_ose_micro_postman_admin_v1_service_proto_depIdxs,
+	}.Build()
+	File_ose_micro_postman_admin_v1_service_proto = out.File
+	file_ose_micro_postman_admin_v1_service_proto_goTypes = nil
+	file_ose_micro_postman_admin_v1_service_proto_depIdxs = nil
+}
@@ -26,22 +26,52 @@ const (
 type State int32
 
 const (
-	State_StateUnknown  State = 0
-	State_StateFailed   State = 1
-	State_StateComplete State = 2
+	State_StateUnknown State = 0
+	State_StateFailed  State = 1
+	// Superseded by StateSent.
+	//
+	// Deprecated: Marked as deprecated in ose/micro/postman/email/v1/data.proto.
+	State_StateComplete   State = 2
+	State_StateQueued     State = 3
+	State_StateScheduled  State = 4
+	State_StateSending    State = 5
+	State_StateSent       State = 6
+	State_StateDelivered  State = 7
+	State_StateBounced    State = 8
+	State_StateComplained State = 9
+	State_StateCancelled  State = 10
+	State_StateSuppressed State = 11
 )
 
 // Enum value maps for State.
 var (
 	State_name = map[int32]string{
-		0: "StateUnknown",
-		1: "StateFailed",
-		2: "StateComplete",
+		0:  "StateUnknown",
+		1:  "StateFailed",
+		2:  "StateComplete",
+		3:  "StateQueued",
+		4:  "StateScheduled",
+		5:  "StateSending",
+		6:  "StateSent",
+		7:  "StateDelivered",
+		8:  "StateBounced",
+		9:  "StateComplained",
+		10: "StateCancelled",
+		11: "StateSuppressed",
 	}
 	State_value = map[string]int32{
-		"StateUnknown":  0,
-		"StateFailed":   1,
-		"StateComplete": 2,
+		"StateUnknown":    0,
+		"StateFailed":     1,
+		"StateComplete":   2,
+		"StateQueued":     3,
+		"StateScheduled":  4,
+		"StateSending":    5,
+		"StateSent":       6,
+		"StateDelivered":  7,
+		"StateBounced":    8,
+		"StateComplained": 9,
+		"StateCancelled":  10,
+		"StateSuppressed": 11,
 	}
 )
 
@@ -72,27 +102,250 @@ func (State) EnumDescriptor() ([]byte, []int) {
 	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{0}
 }
 
+type Attempt struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	At       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
+	Provider string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// SMTP reply code and text, when the server gave one.
+	Code       int32  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Response   string `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+	Error      string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs int64  `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	MessageId  string `protobuf:"bytes,7,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// Id the provider assigned to the message, when it returned one.
+	ProviderId    string `protobuf:"bytes,8,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attempt) Reset() {
+	*x = Attempt{}
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attempt) ProtoMessage() {}
+
+func (x *Attempt) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attempt.ProtoReflect.Descriptor instead.
+func (*Attempt) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Attempt) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *Attempt) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Attempt) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Attempt) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *Attempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Attempt) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *Attempt) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *Attempt) GetProviderId() string {
+	if x != nil {
+		return x.ProviderId
+	}
+	return ""
+}
+
+// Attachment carries either content or the blob id of an attachment stored
+// with an earlier email. Emails return metadata only, with blob set.
+type Attachment struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Filename    string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content     []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Blob        string                 `protobuf:"bytes,4,opt,name=blob,proto3" json:"blob,omitempty"`
+	// Referenced from the HTML body as cid:<content_id> when inline is set.
+	ContentId     string `protobuf:"bytes,5,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	Inline        bool   `protobuf:"varint,6,opt,name=inline,proto3" json:"inline,omitempty"`
+	Size          int64  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *Attachment) GetBlob() string {
+	if x != nil {
+		return x.Blob
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentId() string {
+	if x != nil {
+		return x.ContentId
+	}
+	return ""
+}
+
+func (x *Attachment) GetInline() bool {
+	if x != nil {
+		return x.Inline
+	}
+	return false
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
 type Email struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Recipient     string                 `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Data          map[string]string      `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Subject       string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
-	Sender        string                 `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
-	From          string                 `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
-	Template      string                 `protobuf:"bytes,7,opt,name=template,proto3" json:"template,omitempty"`
-	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
-	State         State                  `protobuf:"varint,9,opt,name=state,proto3,enum=ose.micro.postman.email.v1.State" json:"state,omitempty"`
-	Count         int32                  `protobuf:"varint,10,opt,name=count,proto3" json:"count,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Recipient       string                 `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Data            map[string]string      `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Subject         string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Sender          string                 `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	From            string                 `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	Template        string                 `protobuf:"bytes,7,opt,name=template,proto3" json:"template,omitempty"`
+	Message         string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	State           State                  `protobuf:"varint,9,opt,name=state,proto3,enum=ose.micro.postman.email.v1.State" json:"state,omitempty"`
+	Count           int32                  `protobuf:"varint,10,opt,name=count,proto3" json:"count,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TemplateVersion int32                  `protobuf:"varint,13,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`
+	// Rendered plain-text alternative of message.
+	Text string `protobuf:"bytes,14,opt,name=text,proto3" json:"text,omitempty"`
+	// Error from the most recent failed delivery attempt.
+	LastError string `protobuf:"bytes,15,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Set while a transient failure waits to be retried.
+	RetryAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=retry_at,json=retryAt,proto3" json:"retry_at,omitempty"`
+	// Delivery history, oldest first. count is its length.
+	Attempts       []*Attempt             `protobuf:"bytes,17,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	SendAt         *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,19,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	To             []string               `protobuf:"bytes,20,rep,name=to,proto3" json:"to,omitempty"`
+	Cc             []string               `protobuf:"bytes,21,rep,name=cc,proto3" json:"cc,omitempty"`
+	Bcc            []string               `protobuf:"bytes,22,rep,name=bcc,proto3" json:"bcc,omitempty"`
+	ReplyTo        string                 `protobuf:"bytes,23,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	Attachments    []*Attachment          `protobuf:"bytes,24,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Headers        map[string]string      `protobuf:"bytes,25,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Tags           map[string]string      `protobuf:"bytes,26,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Message-ID header of the latest attempt, angle brackets included. Filter
+	// on "attempts.message_id" in Read to match any attempt.
+	MessageId string `protobuf:"bytes,27,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// Configured delivery provider that accepted the email.
+	Provider string `protobuf:"bytes,28,opt,name=provider,proto3" json:"provider,omitempty"`
+	Category string `protobuf:"bytes,29,opt,name=category,proto3" json:"category,omitempty"`
+	// Recipients dropped at creation because they are on the suppression list.
+	Suppressed    []string `protobuf:"bytes,30,rep,name=suppressed,proto3" json:"suppressed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Email) Reset() {
 	*x = Email{}
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[0]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -104,7 +357,7 @@ func (x *Email) String() string {
 func (*Email) ProtoMessage() {}
 
 func (x *Email) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[0]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -117,7 +370,7 @@ func (x *Email) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Email.ProtoReflect.Descriptor instead.
 func (*Email) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{0}
+	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Email) GetId() string {
@@ -204,20 +457,167 @@ func (x *Email) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Email) GetTemplateVersion() int32 {
+	if x != nil {
+		return x.TemplateVersion
+	}
+	return 0
+}
+
+func (x *Email) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Email) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Email) GetRetryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetryAt
+	}
+	return nil
+}
+
+func (x *Email) GetAttempts() []*Attempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+func (x *Email) GetSendAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+func (x *Email) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *Email) GetTo() []string {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *Email) GetCc() []string {
+	if x != nil {
+		return x.Cc
+	}
+	return nil
+}
+
+func (x *Email) GetBcc() []string {
+	if x != nil {
+		return x.Bcc
+	}
+	return nil
+}
+
+func (x *Email) GetReplyTo() string {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return ""
+}
+
+func (x *Email) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Email) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Email) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Email) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *Email) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Email) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Email) GetSuppressed() []string {
+	if x != nil {
+		return x.Suppressed
+	}
+	return nil
+}
+
 type CreateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Recipient     string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Data          map[string]string      `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Sender        string                 `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
-	Template      string                 `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
-	From          string                 `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Recipient string                 `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Data      map[string]string      `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Sender    string                 `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Template id or key.
+	Template string `protobuf:"bytes,4,opt,name=template,proto3" json:"template,omitempty"`
+	From     string `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	// Delivers no earlier than this time. Unset or past sends immediately.
+	SendAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"`
+	// Retries with the same key return the original email instead of sending again.
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Additional To addresses; recipient, when set, is the first.
+	// Addresses follow RFC 5322 and may carry a display name.
+	To []string `protobuf:"bytes,8,rep,name=to,proto3" json:"to,omitempty"`
+	Cc []string `protobuf:"bytes,9,rep,name=cc,proto3" json:"cc,omitempty"`
+	// Never written into message headers.
+	Bcc         []string      `protobuf:"bytes,10,rep,name=bcc,proto3" json:"bcc,omitempty"`
+	ReplyTo     string        `protobuf:"bytes,11,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	Attachments []*Attachment `protobuf:"bytes,12,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// Extra message headers such as List-Id or In-Reply-To. From, To, Cc, Bcc,
+	// Reply-To, Subject, Date, Message-ID and MIME headers are reserved.
+	Headers map[string]string `protobuf:"bytes,13,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Stored but never sent; filter on them in Read as "tags.<key>".
+	Tags map[string]string `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Such as marketing or transactional. Suppressions scoped to another
+	// category do not apply.
+	Category      string `protobuf:"bytes,15,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateRequest) Reset() {
 	*x = CreateRequest{}
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[1]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -229,7 +629,7 @@ func (x *CreateRequest) String() string {
 func (*CreateRequest) ProtoMessage() {}
 
 func (x *CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[1]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -242,7 +642,7 @@ func (x *CreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{1}
+	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateRequest) GetRecipient() string {
@@ -280,6 +680,76 @@ func (x *CreateRequest) GetFrom() string {
 	return ""
 }
 
+func (x *CreateRequest) GetSendAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetTo() []string {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetCc() []string {
+	if x != nil {
+		return x.Cc
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetBcc() []string {
+	if x != nil {
+		return x.Bcc
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetReplyTo() string {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
 type CreateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -290,7 +760,7 @@ type CreateResponse struct {
 
 func (x *CreateResponse) Reset() {
 	*x = CreateResponse{}
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[2]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -302,7 +772,7 @@ func (x *CreateResponse) String() string {
 func (*CreateResponse) ProtoMessage() {}
 
 func (x *CreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[2]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,7 +785,7 @@ func (x *CreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{2}
+	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateResponse) GetMessage() string {
@@ -341,7 +811,7 @@ type ResendRequest struct {
 
 func (x *ResendRequest) Reset() {
 	*x = ResendRequest{}
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[3]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -353,7 +823,7 @@ func (x *ResendRequest) String() string {
 func (*ResendRequest) ProtoMessage() {}
 
 func (x *ResendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[3]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -366,7 +836,7 @@ func (x *ResendRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResendRequest.ProtoReflect.Descriptor instead.
 func (*ResendRequest) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{3}
+	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ResendRequest) GetId() string {
@@ -385,7 +855,7 @@ type ResendResponse struct {
 
 func (x *ResendResponse) Reset() {
 	*x = ResendResponse{}
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[4]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -397,7 +867,7 @@ func (x *ResendResponse) String() string {
 func (*ResendResponse) ProtoMessage() {}
 
 func (x *ResendResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[4]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,7 +880,7 @@ func (x *ResendResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResendResponse.ProtoReflect.Descriptor instead.
 func (*ResendResponse) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{4}
+	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ResendResponse) GetMessage() string {
@@ -420,6 +890,102 @@ func (x *ResendResponse) GetMessage() string {
 	return ""
 }
 
+type CancelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CancelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Record        *Email                 `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelResponse) Reset() {
+	*x = CancelResponse{}
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelResponse) ProtoMessage() {}
+
+func (x *CancelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelResponse.ProtoReflect.Descriptor instead.
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelResponse) GetRecord() *Email {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
 type Emails struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          []*Email               `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
@@ -429,7 +995,7 @@ type Emails struct {
 
 func (x *Emails) Reset() {
 	*x = Emails{}
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[5]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -441,7 +1007,7 @@ func (x *Emails) String() string {
 func (*Emails) ProtoMessage() {}
 
 func (x *Emails) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[5]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +1020,7 @@ func (x *Emails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Emails.ProtoReflect.Descriptor instead.
 func (*Emails) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{5}
+	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Emails) GetData() []*Email {
@@ -473,7 +1039,7 @@ type ReadRequest struct {
 
 func (x *ReadRequest) Reset() {
 	*x = ReadRequest{}
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[6]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -485,7 +1051,7 @@ func (x *ReadRequest) String() string {
 func (*ReadRequest) ProtoMessage() {}
 
 func (x *ReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[6]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +1064,7 @@ func (x *ReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadRequest.ProtoReflect.Descriptor instead.
 func (*ReadRequest) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{6}
+	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ReadRequest) GetRequest() *v1.Request {
@@ -517,7 +1083,7 @@ type ReadResponse struct {
 
 func (x *ReadResponse) Reset() {
 	*x = ReadResponse{}
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[7]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -529,7 +1095,7 @@ func (x *ReadResponse) String() string {
 func (*ReadResponse) ProtoMessage() {}
 
 func (x *ReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[7]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,7 +1108,7 @@ func (x *ReadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadResponse.ProtoReflect.Descriptor instead.
 func (*ReadResponse) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{7}
+	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ReadResponse) GetResult() map[string]*Emails {
@@ -561,7 +1127,7 @@ type DeleteRequest struct {
 
 func (x *DeleteRequest) Reset() {
 	*x = DeleteRequest{}
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[8]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +1139,7 @@ func (x *DeleteRequest) String() string {
 func (*DeleteRequest) ProtoMessage() {}
 
 func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[8]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +1152,7 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{8}
+	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteRequest) GetId() string {
@@ -606,7 +1172,7 @@ type DeleteResponse struct {
 
 func (x *DeleteResponse) Reset() {
 	*x = DeleteResponse{}
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[9]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -618,7 +1184,7 @@ func (x *DeleteResponse) String() string {
 func (*DeleteResponse) ProtoMessage() {}
 
 func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[9]
+	mi := &file_ose_micro_postman_email_v1_data_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,7 +1197,7 @@ func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{9}
+	return file_ose_micro_postman_email_v1_data_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteResponse) GetMessage() string {
@@ -652,7 +1218,30 @@ var File_ose_micro_postman_email_v1_data_proto protoreflect.FileDescriptor
 
 const file_ose_micro_postman_email_v1_data_proto_rawDesc = "" +
 	"\n" +
-	"%ose/micro/postman/email/v1/data.proto\x12\x1aose.micro.postman.email.v1\x1a!ose/micro/common/v1/request.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf0\x03\n" +
+	"%ose/micro/postman/email/v1/data.proto\x12\x1aose.micro.postman.email.v1\x1a!ose/micro/common/v1/request.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf8\x01\n" +
+	"\aAttempt\x12*\n" +
+	"\x02at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12\x1a\n" +
+	"\bresponse\x18\x04 \x01(\tR\bresponse\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1f\n" +
+	"\vduration_ms\x18\x06 \x01(\x03R\n" +
+	"durationMs\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\a \x01(\tR\tmessageId\x12\x1f\n" +
+	"\vprovider_id\x18\b \x01(\tR\n" +
+	"providerId\"\xc4\x01\n" +
+	"\n" +
+	"Attachment\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\x12\x12\n" +
+	"\x04blob\x18\x04 \x01(\tR\x04blob\x12\x1d\n" +
+	"\n" +
+	"content_id\x18\x05 \x01(\tR\tcontentId\x12\x16\n" +
+	"\x06inline\x18\x06 \x01(\bR\x06inline\x12\x12\n" +
+	"\x04size\x18\a \x01(\x03R\x04size\"\xb2\n" +
+	"\n" +
 	"\x05Email\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\trecipient\x18\x02 \x01(\tR\trecipient\x12?\n" +
@@ -668,18 +1257,63 @@ const file_ose_micro_postman_email_v1_data_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a7\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12)\n" +
+	"\x10template_version\x18\r \x01(\x05R\x0ftemplateVersion\x12\x12\n" +
+	"\x04text\x18\x0e \x01(\tR\x04text\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x0f \x01(\tR\tlastError\x125\n" +
+	"\bretry_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\aretryAt\x12?\n" +
+	"\battempts\x18\x11 \x03(\v2#.ose.micro.postman.email.v1.AttemptR\battempts\x123\n" +
+	"\asend_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\x06sendAt\x12'\n" +
+	"\x0fidempotency_key\x18\x13 \x01(\tR\x0eidempotencyKey\x12\x0e\n" +
+	"\x02to\x18\x14 \x03(\tR\x02to\x12\x0e\n" +
+	"\x02cc\x18\x15 \x03(\tR\x02cc\x12\x10\n" +
+	"\x03bcc\x18\x16 \x03(\tR\x03bcc\x12\x19\n" +
+	"\breply_to\x18\x17 \x01(\tR\areplyTo\x12H\n" +
+	"\vattachments\x18\x18 \x03(\v2&.ose.micro.postman.email.v1.AttachmentR\vattachments\x12H\n" +
+	"\aheaders\x18\x19 \x03(\v2..ose.micro.postman.email.v1.Email.HeadersEntryR\aheaders\x12?\n" +
+	"\x04tags\x18\x1a \x03(\v2+.ose.micro.postman.email.v1.Email.TagsEntryR\x04tags\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x1b \x01(\tR\tmessageId\x12\x1a\n" +
+	"\bprovider\x18\x1c \x01(\tR\bprovider\x12\x1a\n" +
+	"\bcategory\x18\x1d \x01(\tR\bcategory\x12\x1e\n" +
+	"\n" +
+	"suppressed\x18\x1e \x03(\tR\n" +
+	"suppressed\x1a7\n" +
 	"\tDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf7\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x98\x06\n" +
 	"\rCreateRequest\x12\x1c\n" +
 	"\trecipient\x18\x01 \x01(\tR\trecipient\x12G\n" +
 	"\x04data\x18\x02 \x03(\v23.ose.micro.postman.email.v1.CreateRequest.DataEntryR\x04data\x12\x16\n" +
 	"\x06sender\x18\x03 \x01(\tR\x06sender\x12\x1a\n" +
 	"\btemplate\x18\x04 \x01(\tR\btemplate\x12\x12\n" +
-	"\x04from\x18\x05 \x01(\tR\x04from\x1a7\n" +
+	"\x04from\x18\x05 \x01(\tR\x04from\x123\n" +
+	"\asend_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06sendAt\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\x12\x0e\n" +
+	"\x02to\x18\b \x03(\tR\x02to\x12\x0e\n" +
+	"\x02cc\x18\t \x03(\tR\x02cc\x12\x10\n" +
+	"\x03bcc\x18\n" +
+	" \x03(\tR\x03bcc\x12\x19\n" +
+	"\breply_to\x18\v \x01(\tR\areplyTo\x12H\n" +
+	"\vattachments\x18\f \x03(\v2&.ose.micro.postman.email.v1.AttachmentR\vattachments\x12P\n" +
+	"\aheaders\x18\r \x03(\v26.ose.micro.postman.email.v1.CreateRequest.HeadersEntryR\aheaders\x12G\n" +
+	"\x04tags\x18\x0e \x03(\v23.ose.micro.postman.email.v1.CreateRequest.TagsEntryR\x04tags\x12\x1a\n" +
+	"\bcategory\x18\x0f \x01(\tR\bcategory\x1a7\n" +
 	"\tDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"e\n" +
 	"\x0eCreateResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x129\n" +
@@ -687,7 +1321,12 @@ const file_ose_micro_postman_email_v1_data_proto_rawDesc = "" +
 	"\rResendRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"*\n" +
 	"\x0eResendResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"?\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x1f\n" +
+	"\rCancelRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"e\n" +
+	"\x0eCancelResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x129\n" +
+	"\x06record\x18\x02 \x01(\v2!.ose.micro.postman.email.v1.EmailR\x06record\"?\n" +
 	"\x06Emails\x125\n" +
 	"\x04data\x18\x01 \x03(\v2!.ose.micro.postman.email.v1.EmailR\x04data\"E\n" +
 	"\vReadRequest\x126\n" +
@@ -701,11 +1340,21 @@ const file_ose_micro_postman_email_v1_data_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"e\n" +
 	"\x0eDeleteResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x129\n" +
-	"\x06record\x18\x02 \x01(\v2!.ose.micro.postman.email.v1.EmailR\x06record*=\n" +
+	"\x06record\x18\x02 \x01(\v2!.ose.micro.postman.email.v1.EmailR\x06record*\xeb\x01\n" +
 	"\x05State\x12\x10\n" +
 	"\fStateUnknown\x10\x00\x12\x0f\n" +
-	"\vStateFailed\x10\x01\x12\x11\n" +
-	"\rStateComplete\x10\x02B\x92\x02\n" +
+	"\vStateFailed\x10\x01\x12\x15\n" +
+	"\rStateComplete\x10\x02\x1a\x02\b\x01\x12\x0f\n" +
+	"\vStateQueued\x10\x03\x12\x12\n" +
+	"\x0eStateScheduled\x10\x04\x12\x10\n" +
+	"\fStateSending\x10\x05\x12\r\n" +
+	"\tStateSent\x10\x06\x12\x12\n" +
+	"\x0eStateDelivered\x10\a\x12\x10\n" +
+	"\fStateBounced\x10\b\x12\x13\n" +
+	"\x0fStateComplained\x10\t\x12\x12\n" +
+	"\x0eStateCancelled\x10\n" +
+	"\x12\x13\n" +
+	"\x0fStateSuppressed\x10\vB\x92\x02\n" +
 	"\x1ecom.ose.micro.postman.email.v1B\tDataProtoP\x01ZXgithub.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/email/v1;emailv1\xa2\x02\x04OMPE\xaa\x02\x1aOse.Micro.Postman.Email.V1\xca\x02\x1aOse\\Micro\\Postman\\Email\\V1\xe2\x02&Ose\\Micro\\Postman\\Email\\V1\\GPBMetadata\xea\x02\x1eOse::Micro::Postman::Email::V1b\x06proto3"
 
 var (
@@ -721,42 +1370,62 @@ func file_ose_micro_postman_email_v1_data_proto_rawDescGZIP() []byte {
 }
 
 var file_ose_micro_postman_email_v1_data_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ose_micro_postman_email_v1_data_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_ose_micro_postman_email_v1_data_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_ose_micro_postman_email_v1_data_proto_goTypes = []any{
 	(State)(0),                    // 0: ose.micro.postman.email.v1.State
-	(*Email)(nil),                 // 1: ose.micro.postman.email.v1.Email
-	(*CreateRequest)(nil),         // 2: ose.micro.postman.email.v1.CreateRequest
-	(*CreateResponse)(nil),        // 3: ose.micro.postman.email.v1.CreateResponse
-	(*ResendRequest)(nil),         // 4: ose.micro.postman.email.v1.ResendRequest
-	(*ResendResponse)(nil),        // 5: ose.micro.postman.email.v1.ResendResponse
-	(*Emails)(nil),                // 6: ose.micro.postman.email.v1.Emails
-	(*ReadRequest)(nil),           // 7: ose.micro.postman.email.v1.ReadRequest
-	(*ReadResponse)(nil),          // 8: ose.micro.postman.email.v1.ReadResponse
-	(*DeleteRequest)(nil),         // 9: ose.micro.postman.email.v1.DeleteRequest
-	(*DeleteResponse)(nil),        // 10: ose.micro.postman.email.v1.DeleteResponse
-	nil,                           // 11: ose.micro.postman.email.v1.Email.DataEntry
-	nil,                           // 12: ose.micro.postman.email.v1.CreateRequest.DataEntry
-	nil,                           // 13: ose.micro.postman.email.v1.ReadResponse.ResultEntry
-	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
-	(*v1.Request)(nil),            // 15: ose.micro.common.v1.Request
+	(*Attempt)(nil),               // 1: ose.micro.postman.email.v1.Attempt
+	(*Attachment)(nil),            // 2: ose.micro.postman.email.v1.Attachment
+	(*Email)(nil),                 // 3: ose.micro.postman.email.v1.Email
+	(*CreateRequest)(nil),         // 4: ose.micro.postman.email.v1.CreateRequest
+	(*CreateResponse)(nil),        // 5: ose.micro.postman.email.v1.CreateResponse
+	(*ResendRequest)(nil),         // 6: ose.micro.postman.email.v1.ResendRequest
+	(*ResendResponse)(nil),        // 7: ose.micro.postman.email.v1.ResendResponse
+	(*CancelRequest)(nil),         // 8: ose.micro.postman.email.v1.CancelRequest
+	(*CancelResponse)(nil),        // 9: ose.micro.postman.email.v1.CancelResponse
+	(*Emails)(nil),                // 10: ose.micro.postman.email.v1.Emails
+	(*ReadRequest)(nil),           // 11: ose.micro.postman.email.v1.ReadRequest
+	(*ReadResponse)(nil),          // 12: ose.micro.postman.email.v1.ReadResponse
+	(*DeleteRequest)(nil),         // 13: ose.micro.postman.email.v1.DeleteRequest
+	(*DeleteResponse)(nil),        // 14: ose.micro.postman.email.v1.DeleteResponse
+	nil,                           // 15: ose.micro.postman.email.v1.Email.DataEntry
+	nil,                           // 16: ose.micro.postman.email.v1.Email.HeadersEntry
+	nil,                           // 17: ose.micro.postman.email.v1.Email.TagsEntry
+	nil,                           // 18: ose.micro.postman.email.v1.CreateRequest.DataEntry
+	nil,                           // 19: ose.micro.postman.email.v1.CreateRequest.HeadersEntry
+	nil,                           // 20: ose.micro.postman.email.v1.CreateRequest.TagsEntry
+	nil,                           // 21: ose.micro.postman.email.v1.ReadResponse.ResultEntry
+	(*timestamppb.Timestamp)(nil), // 22: google.protobuf.Timestamp
+	(*v1.Request)(nil),            // 23: ose.micro.common.v1.Request
 }
 var file_ose_micro_postman_email_v1_data_proto_depIdxs = []int32{
-	11, // 0: ose.micro.postman.email.v1.Email.data:type_name -> ose.micro.postman.email.v1.Email.DataEntry
-	0,  // 1: ose.micro.postman.email.v1.Email.state:type_name -> ose.micro.postman.email.v1.State
-	14, // 2: ose.micro.postman.email.v1.Email.created_at:type_name -> google.protobuf.Timestamp
-	14, // 3: ose.micro.postman.email.v1.Email.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 4: ose.micro.postman.email.v1.CreateRequest.data:type_name -> ose.micro.postman.email.v1.CreateRequest.DataEntry
-	1,  // 5: ose.micro.postman.email.v1.CreateResponse.record:type_name -> ose.micro.postman.email.v1.Email
-	1,  // 6: ose.micro.postman.email.v1.Emails.data:type_name -> ose.micro.postman.email.v1.Email
-	15, // 7: ose.micro.postman.email.v1.ReadRequest.request:type_name -> ose.micro.common.v1.Request
-	13, // 8: ose.micro.postman.email.v1.ReadResponse.result:type_name -> ose.micro.postman.email.v1.ReadResponse.ResultEntry
-	1,  // 9: ose.micro.postman.email.v1.DeleteResponse.record:type_name -> ose.micro.postman.email.v1.Email
-	6,  // 10: ose.micro.postman.email.v1.ReadResponse.ResultEntry.value:type_name -> ose.micro.postman.email.v1.Emails
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	22, // 0: ose.micro.postman.email.v1.Attempt.at:type_name -> google.protobuf.Timestamp
+	15, // 1: ose.micro.postman.email.v1.Email.data:type_name -> ose.micro.postman.email.v1.Email.DataEntry
+	0,  // 2: ose.micro.postman.email.v1.Email.state:type_name -> ose.micro.postman.email.v1.State
+	22, // 3: ose.micro.postman.email.v1.Email.created_at:type_name -> google.protobuf.Timestamp
+	22, // 4: ose.micro.postman.email.v1.Email.updated_at:type_name -> google.protobuf.Timestamp
+	22, // 5: ose.micro.postman.email.v1.Email.retry_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: ose.micro.postman.email.v1.Email.attempts:type_name -> ose.micro.postman.email.v1.Attempt
+	22, // 7: ose.micro.postman.email.v1.Email.send_at:type_name -> google.protobuf.Timestamp
+	2,  // 8: ose.micro.postman.email.v1.Email.attachments:type_name -> ose.micro.postman.email.v1.Attachment
+	16, // 9: ose.micro.postman.email.v1.Email.headers:type_name -> ose.micro.postman.email.v1.Email.HeadersEntry
+	17, // 10: ose.micro.postman.email.v1.Email.tags:type_name -> ose.micro.postman.email.v1.Email.TagsEntry
+	18, // 11: ose.micro.postman.email.v1.CreateRequest.data:type_name -> ose.micro.postman.email.v1.CreateRequest.DataEntry
+	22, // 12: ose.micro.postman.email.v1.CreateRequest.send_at:type_name -> google.protobuf.Timestamp
+	2,  // 13: ose.micro.postman.email.v1.CreateRequest.attachments:type_name -> ose.micro.postman.email.v1.Attachment
+	19, // 14: ose.micro.postman.email.v1.CreateRequest.headers:type_name -> ose.micro.postman.email.v1.CreateRequest.HeadersEntry
+	20, // 15: ose.micro.postman.email.v1.CreateRequest.tags:type_name -> ose.micro.postman.email.v1.CreateRequest.TagsEntry
+	3,  // 16: ose.micro.postman.email.v1.CreateResponse.record:type_name -> ose.micro.postman.email.v1.Email
+	3,  // 17: ose.micro.postman.email.v1.CancelResponse.record:type_name -> ose.micro.postman.email.v1.Email
+	3,  // 18: ose.micro.postman.email.v1.Emails.data:type_name -> ose.micro.postman.email.v1.Email
+	23, // 19: ose.micro.postman.email.v1.ReadRequest.request:type_name -> ose.micro.common.v1.Request
+	21, // 20: ose.micro.postman.email.v1.ReadResponse.result:type_name -> ose.micro.postman.email.v1.ReadResponse.ResultEntry
+	3,  // 21: ose.micro.postman.email.v1.DeleteResponse.record:type_name -> ose.micro.postman.email.v1.Email
+	10, // 22: ose.micro.postman.email.v1.ReadResponse.ResultEntry.value:type_name -> ose.micro.postman.email.v1.Emails
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_ose_micro_postman_email_v1_data_proto_init() }
@@ -770,7 +1439,7 @@ func file_ose_micro_postman_email_v1_data_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ose_micro_postman_email_v1_data_proto_rawDesc), len(file_ose_micro_postman_email_v1_data_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   13,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
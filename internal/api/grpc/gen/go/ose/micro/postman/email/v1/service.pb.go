@@ -24,12 +24,13 @@ var File_ose_micro_postman_email_v1_service_proto protoreflect.FileDescriptor
 
 const file_ose_micro_postman_email_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"(ose/micro/postman/email/v1/service.proto\x12\x1aose.micro.postman.email.v1\x1a%ose/micro/postman/email/v1/data.proto2\x8c\x03\n" +
+	"(ose/micro/postman/email/v1/service.proto\x12\x1aose.micro.postman.email.v1\x1a%ose/micro/postman/email/v1/data.proto2\xed\x03\n" +
 	"\fEmailService\x12_\n" +
 	"\x06Create\x12).ose.micro.postman.email.v1.CreateRequest\x1a*.ose.micro.postman.email.v1.CreateResponse\x12Y\n" +
 	"\x04Read\x12'.ose.micro.postman.email.v1.ReadRequest\x1a(.ose.micro.postman.email.v1.ReadResponse\x12_\n" +
 	"\x06Resend\x12).ose.micro.postman.email.v1.ResendRequest\x1a*.ose.micro.postman.email.v1.ResendResponse\x12_\n" +
-	"\x06Delete\x12).ose.micro.postman.email.v1.DeleteRequest\x1a*.ose.micro.postman.email.v1.DeleteResponseB\x95\x02\n" +
+	"\x06Delete\x12).ose.micro.postman.email.v1.DeleteRequest\x1a*.ose.micro.postman.email.v1.DeleteResponse\x12_\n" +
+	"\x06Cancel\x12).ose.micro.postman.email.v1.CancelRequest\x1a*.ose.micro.postman.email.v1.CancelResponseB\x95\x02\n" +
 	"\x1ecom.ose.micro.postman.email.v1B\fServiceProtoP\x01ZXgithub.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/email/v1;emailv1\xa2\x02\x04OMPE\xaa\x02\x1aOse.Micro.Postman.Email.V1\xca\x02\x1aOse\\Micro\\Postman\\Email\\V1\xe2\x02&Ose\\Micro\\Postman\\Email\\V1\\GPBMetadata\xea\x02\x1eOse::Micro::Postman::Email::V1b\x06proto3"
 
 var file_ose_micro_postman_email_v1_service_proto_goTypes = []any{
@@ -37,22 +38,26 @@ var file_ose_micro_postman_email_v1_service_proto_goTypes = []any{
 	(*ReadRequest)(nil),    // 1: ose.micro.postman.email.v1.ReadRequest
 	(*ResendRequest)(nil),  // 2: ose.micro.postman.email.v1.ResendRequest
 	(*DeleteRequest)(nil),  // 3: ose.micro.postman.email.v1.DeleteRequest
-	(*CreateResponse)(nil), // 4: ose.micro.postman.email.v1.CreateResponse
-	(*ReadResponse)(nil),   // 5: ose.micro.postman.email.v1.ReadResponse
-	(*ResendResponse)(nil), // 6: ose.micro.postman.email.v1.ResendResponse
-	(*DeleteResponse)(nil), // 7: ose.micro.postman.email.v1.DeleteResponse
+	(*CancelRequest)(nil),  // 4: ose.micro.postman.email.v1.CancelRequest
+	(*CreateResponse)(nil), // 5: ose.micro.postman.email.v1.CreateResponse
+	(*ReadResponse)(nil),   // 6: ose.micro.postman.email.v1.ReadResponse
+	(*ResendResponse)(nil), // 7: ose.micro.postman.email.v1.ResendResponse
+	(*DeleteResponse)(nil), // 8: ose.micro.postman.email.v1.DeleteResponse
+	(*CancelResponse)(nil), // 9: ose.micro.postman.email.v1.CancelResponse
 }
 var file_ose_micro_postman_email_v1_service_proto_depIdxs = []int32{
 	0, // 0: ose.micro.postman.email.v1.EmailService.Create:input_type -> ose.micro.postman.email.v1.CreateRequest
 	1, // 1: ose.micro.postman.email.v1.EmailService.Read:input_type -> ose.micro.postman.email.v1.ReadRequest
 	2, // 2: ose.micro.postman.email.v1.EmailService.Resend:input_type -> ose.micro.postman.email.v1.ResendRequest
 	3, // 3: ose.micro.postman.email.v1.EmailService.Delete:input_type -> ose.micro.postman.email.v1.DeleteRequest
-	4, // 4: ose.micro.postman.email.v1.EmailService.Create:output_type -> ose.micro.postman.email.v1.CreateResponse
-	5, // 5: ose.micro.postman.email.v1.EmailService.Read:output_type -> ose.micro.postman.email.v1.ReadResponse
-	6, // 6: ose.micro.postman.email.v1.EmailService.Resend:output_type -> ose.micro.postman.email.v1.ResendResponse
-	7, // 7: ose.micro.postman.email.v1.EmailService.Delete:output_type -> ose.micro.postman.email.v1.DeleteResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
+	4, // 4: ose.micro.postman.email.v1.EmailService.Cancel:input_type -> ose.micro.postman.email.v1.CancelRequest
+	5, // 5: ose.micro.postman.email.v1.EmailService.Create:output_type -> ose.micro.postman.email.v1.CreateResponse
+	6, // 6: ose.micro.postman.email.v1.EmailService.Read:output_type -> ose.micro.postman.email.v1.ReadResponse
+	7, // 7: ose.micro.postman.email.v1.EmailService.Resend:output_type -> ose.micro.postman.email.v1.ResendResponse
+	8, // 8: ose.micro.postman.email.v1.EmailService.Delete:output_type -> ose.micro.postman.email.v1.DeleteResponse
+	9, // 9: ose.micro.postman.email.v1.EmailService.Cancel:output_type -> ose.micro.postman.email.v1.CancelResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -23,6 +23,7 @@ const (
 	EmailService_Read_FullMethodName   = "/ose.micro.postman.email.v1.EmailService/Read"
 	EmailService_Resend_FullMethodName = "/ose.micro.postman.email.v1.EmailService/Resend"
 	EmailService_Delete_FullMethodName = "/ose.micro.postman.email.v1.EmailService/Delete"
+	EmailService_Cancel_FullMethodName = "/ose.micro.postman.email.v1.EmailService/Cancel"
 )
 
 // EmailServiceClient is the client API for EmailService service.
@@ -33,6 +34,8 @@ type EmailServiceClient interface {
 	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
 	Resend(ctx context.Context, in *ResendRequest, opts ...grpc.CallOption) (*ResendResponse, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Cancel stops a queued or scheduled email before it is sent.
+	Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error)
 }
 
 type emailServiceClient struct {
@@ -83,6 +86,16 @@ func (c *emailServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts
 	return out, nil
 }
 
+func (c *emailServiceClient) Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelResponse)
+	err := c.cc.Invoke(ctx, EmailService_Cancel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EmailServiceServer is the server API for EmailService service.
 // All implementations must embed UnimplementedEmailServiceServer
 // for forward compatibility.
@@ -91,6 +104,8 @@ type EmailServiceServer interface {
 	Read(context.Context, *ReadRequest) (*ReadResponse, error)
 	Resend(context.Context, *ResendRequest) (*ResendResponse, error)
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// Cancel stops a queued or scheduled email before it is sent.
+	Cancel(context.Context, *CancelRequest) (*CancelResponse, error)
 	mustEmbedUnimplementedEmailServiceServer()
 }
 
@@ -113,6 +128,9 @@ func (UnimplementedEmailServiceServer) Resend(context.Context, *ResendRequest) (
 func (UnimplementedEmailServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedEmailServiceServer) Cancel(context.Context, *CancelRequest) (*CancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
 func (UnimplementedEmailServiceServer) mustEmbedUnimplementedEmailServiceServer() {}
 func (UnimplementedEmailServiceServer) testEmbeddedByValue()                      {}
 
@@ -206,6 +224,24 @@ func _EmailService_Delete_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EmailService_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmailService_Cancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).Cancel(ctx, req.(*CancelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EmailService_ServiceDesc is the grpc.ServiceDesc for EmailService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +265,10 @@ var EmailService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _EmailService_Delete_Handler,
 		},
+		{
+			MethodName: "Cancel",
+			Handler:    _EmailService_Cancel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ose/micro/postman/email/v1/service.proto",
@@ -0,0 +1,796 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: ose/micro/postman/suppression/v1/data.proto
+
+package suppressionv1
+
+import (
+	v1 "github.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Reason int32
+
+const (
+	Reason_ReasonUnknown     Reason = 0
+	Reason_ReasonBounce      Reason = 1
+	Reason_ReasonComplaint   Reason = 2
+	Reason_ReasonUnsubscribe Reason = 3
+	Reason_ReasonManual      Reason = 4
+)
+
+// Enum value maps for Reason.
+var (
+	Reason_name = map[int32]string{
+		0: "ReasonUnknown",
+		1: "ReasonBounce",
+		2: "ReasonComplaint",
+		3: "ReasonUnsubscribe",
+		4: "ReasonManual",
+	}
+	Reason_value = map[string]int32{
+		"ReasonUnknown":     0,
+		"ReasonBounce":      1,
+		"ReasonComplaint":   2,
+		"ReasonUnsubscribe": 3,
+		"ReasonManual":      4,
+	}
+)
+
+func (x Reason) Enum() *Reason {
+	p := new(Reason)
+	*p = x
+	return p
+}
+
+func (x Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_ose_micro_postman_suppression_v1_data_proto_enumTypes[0].Descriptor()
+}
+
+func (Reason) Type() protoreflect.EnumType {
+	return &file_ose_micro_postman_suppression_v1_data_proto_enumTypes[0]
+}
+
+func (x Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Reason.Descriptor instead.
+func (Reason) EnumDescriptor() ([]byte, []int) {
+	return file_ose_micro_postman_suppression_v1_data_proto_rawDescGZIP(), []int{0}
+}
+
+type Suppression struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Bare lower-cased mailbox.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Reason  Reason `protobuf:"varint,3,opt,name=reason,proto3,enum=ose.micro.postman.suppression.v1.Reason" json:"reason,omitempty"`
+	// What added the entry, such as api or bounce.
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// Only emails of this category are blocked; empty blocks all mail.
+	Category string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Note     string `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	// Unset keeps the entry until it is removed.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suppression) Reset() {
+	*x = Suppression{}
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suppression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suppression) ProtoMessage() {}
+
+func (x *Suppression) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suppression.ProtoReflect.Descriptor instead.
+func (*Suppression) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_suppression_v1_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Suppression) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Suppression) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Suppression) GetReason() Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Reason_ReasonUnknown
+}
+
+func (x *Suppression) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Suppression) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Suppression) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Suppression) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Suppression) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Suppression) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type AddRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Reason  Reason                 `protobuf:"varint,2,opt,name=reason,proto3,enum=ose.micro.postman.suppression.v1.Reason" json:"reason,omitempty"`
+	// Defaults to api.
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRequest) Reset() {
+	*x = AddRequest{}
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRequest) ProtoMessage() {}
+
+func (x *AddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRequest.ProtoReflect.Descriptor instead.
+func (*AddRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_suppression_v1_data_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddRequest) GetReason() Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Reason_ReasonUnknown
+}
+
+func (x *AddRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AddRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *AddRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AddRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type AddResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Record        *Suppression           `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddResponse) Reset() {
+	*x = AddResponse{}
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddResponse) ProtoMessage() {}
+
+func (x *AddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddResponse.ProtoReflect.Descriptor instead.
+func (*AddResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_suppression_v1_data_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddResponse) GetRecord() *Suppression {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+// RemoveRequest names the entry by id, or by address and category.
+type RemoveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRequest) Reset() {
+	*x = RemoveRequest{}
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRequest) ProtoMessage() {}
+
+func (x *RemoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_suppression_v1_data_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RemoveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RemoveRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type RemoveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveResponse) Reset() {
+	*x = RemoveResponse{}
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveResponse) ProtoMessage() {}
+
+func (x *RemoveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveResponse.ProtoReflect.Descriptor instead.
+func (*RemoveResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_suppression_v1_data_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RemoveResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Suppressions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Suppression         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suppressions) Reset() {
+	*x = Suppressions{}
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suppressions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suppressions) ProtoMessage() {}
+
+func (x *Suppressions) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suppressions.ProtoReflect.Descriptor instead.
+func (*Suppressions) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_suppression_v1_data_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Suppressions) GetData() []*Suppression {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *v1.Request            `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadRequest) Reset() {
+	*x = ReadRequest{}
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRequest) ProtoMessage() {}
+
+func (x *ReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRequest.ProtoReflect.Descriptor instead.
+func (*ReadRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_suppression_v1_data_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReadRequest) GetRequest() *v1.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ReadResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Result        map[string]*Suppressions `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadResponse) Reset() {
+	*x = ReadResponse{}
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadResponse) ProtoMessage() {}
+
+func (x *ReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadResponse.ProtoReflect.Descriptor instead.
+func (*ReadResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_suppression_v1_data_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReadResponse) GetResult() map[string]*Suppressions {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CheckRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Addresses []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// Category of the email about to be sent.
+	Category      string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckRequest) Reset() {
+	*x = CheckRequest{}
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRequest) ProtoMessage() {}
+
+func (x *CheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRequest.ProtoReflect.Descriptor instead.
+func (*CheckRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_suppression_v1_data_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CheckRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *CheckRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type CheckResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Active entries blocking any of the addresses; empty when all may be mailed.
+	Data          []*Suppression `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckResponse) Reset() {
+	*x = CheckResponse{}
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckResponse) ProtoMessage() {}
+
+func (x *CheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_suppression_v1_data_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckResponse.ProtoReflect.Descriptor instead.
+func (*CheckResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_suppression_v1_data_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CheckResponse) GetData() []*Suppression {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_ose_micro_postman_suppression_v1_data_proto protoreflect.FileDescriptor
+
+const file_ose_micro_postman_suppression_v1_data_proto_rawDesc = "" +
+	"\n" +
+	"+ose/micro/postman/suppression/v1/data.proto\x12 ose.micro.postman.suppression.v1\x1a!ose/micro/common/v1/request.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf2\x02\n" +
+	"\vSuppression\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12@\n" +
+	"\x06reason\x18\x03 \x01(\x0e2(.ose.micro.postman.suppression.v1.ReasonR\x06reason\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xeb\x01\n" +
+	"\n" +
+	"AddRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12@\n" +
+	"\x06reason\x18\x02 \x01(\x0e2(.ose.micro.postman.suppression.v1.ReasonR\x06reason\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"n\n" +
+	"\vAddResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12E\n" +
+	"\x06record\x18\x02 \x01(\v2-.ose.micro.postman.suppression.v1.SuppressionR\x06record\"U\n" +
+	"\rRemoveRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\"*\n" +
+	"\x0eRemoveResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"Q\n" +
+	"\fSuppressions\x12A\n" +
+	"\x04data\x18\x01 \x03(\v2-.ose.micro.postman.suppression.v1.SuppressionR\x04data\"E\n" +
+	"\vReadRequest\x126\n" +
+	"\arequest\x18\x01 \x01(\v2\x1c.ose.micro.common.v1.RequestR\arequest\"\xcd\x01\n" +
+	"\fReadResponse\x12R\n" +
+	"\x06result\x18\x01 \x03(\v2:.ose.micro.postman.suppression.v1.ReadResponse.ResultEntryR\x06result\x1ai\n" +
+	"\vResultEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12D\n" +
+	"\x05value\x18\x02 \x01(\v2..ose.micro.postman.suppression.v1.SuppressionsR\x05value:\x028\x01\"H\n" +
+	"\fCheckRequest\x12\x1c\n" +
+	"\taddresses\x18\x01 \x03(\tR\taddresses\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\"R\n" +
+	"\rCheckResponse\x12A\n" +
+	"\x04data\x18\x01 \x03(\v2-.ose.micro.postman.suppression.v1.SuppressionR\x04data*k\n" +
+	"\x06Reason\x12\x11\n" +
+	"\rReasonUnknown\x10\x00\x12\x10\n" +
+	"\fReasonBounce\x10\x01\x12\x13\n" +
+	"\x0fReasonComplaint\x10\x02\x12\x15\n" +
+	"\x11ReasonUnsubscribe\x10\x03\x12\x10\n" +
+	"\fReasonManual\x10\x04B\xbc\x02\n" +
+	"$com.ose.micro.postman.suppression.v1B\tDataProtoP\x01Zdgithub.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/suppression/v1;suppressionv1\xa2\x02\x04OMPS\xaa\x02 Ose.Micro.Postman.Suppression.V1\xca\x02 Ose\\Micro\\Postman\\Suppression\\V1\xe2\x02,Ose\\Micro\\Postman\\Suppression\\V1\\GPBMetadata\xea\x02$Ose::Micro::Postman::Suppression::V1b\x06proto3"
+
+var (
+	file_ose_micro_postman_suppression_v1_data_proto_rawDescOnce sync.Once
+	file_ose_micro_postman_suppression_v1_data_proto_rawDescData []byte
+)
+
+func file_ose_micro_postman_suppression_v1_data_proto_rawDescGZIP() []byte {
+	file_ose_micro_postman_suppression_v1_data_proto_rawDescOnce.Do(func() {
+		file_ose_micro_postman_suppression_v1_data_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ose_micro_postman_suppression_v1_data_proto_rawDesc), len(file_ose_micro_postman_suppression_v1_data_proto_rawDesc)))
+	})
+	return file_ose_micro_postman_suppression_v1_data_proto_rawDescData
+}
+
+var file_ose_micro_postman_suppression_v1_data_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ose_micro_postman_suppression_v1_data_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_ose_micro_postman_suppression_v1_data_proto_goTypes = []any{
+	(Reason)(0),                   // 0: ose.micro.postman.suppression.v1.Reason
+	(*Suppression)(nil),           // 1: ose.micro.postman.suppression.v1.Suppression
+	(*AddRequest)(nil),            // 2: ose.micro.postman.suppression.v1.AddRequest
+	(*AddResponse)(nil),           // 3: ose.micro.postman.suppression.v1.AddResponse
+	(*RemoveRequest)(nil),         // 4: ose.micro.postman.suppression.v1.RemoveRequest
+	(*RemoveResponse)(nil),        // 5: ose.micro.postman.suppression.v1.RemoveResponse
+	(*Suppressions)(nil),          // 6: ose.micro.postman.suppression.v1.Suppressions
+	(*ReadRequest)(nil),           // 7: ose.micro.postman.suppression.v1.ReadRequest
+	(*ReadResponse)(nil),          // 8: ose.micro.postman.suppression.v1.ReadResponse
+	(*CheckRequest)(nil),          // 9: ose.micro.postman.suppression.v1.CheckRequest
+	(*CheckResponse)(nil),         // 10: ose.micro.postman.suppression.v1.CheckResponse
+	nil,                           // 11: ose.micro.postman.suppression.v1.ReadResponse.ResultEntry
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+	(*v1.Request)(nil),            // 13: ose.micro.common.v1.Request
+}
+var file_ose_micro_postman_suppression_v1_data_proto_depIdxs = []int32{
+	0,  // 0: ose.micro.postman.suppression.v1.Suppression.reason:type_name -> ose.micro.postman.suppression.v1.Reason
+	12, // 1: ose.micro.postman.suppression.v1.Suppression.expires_at:type_name -> google.protobuf.Timestamp
+	12, // 2: ose.micro.postman.suppression.v1.Suppression.created_at:type_name -> google.protobuf.Timestamp
+	12, // 3: ose.micro.postman.suppression.v1.Suppression.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: ose.micro.postman.suppression.v1.AddRequest.reason:type_name -> ose.micro.postman.suppression.v1.Reason
+	12, // 5: ose.micro.postman.suppression.v1.AddRequest.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: ose.micro.postman.suppression.v1.AddResponse.record:type_name -> ose.micro.postman.suppression.v1.Suppression
+	1,  // 7: ose.micro.postman.suppression.v1.Suppressions.data:type_name -> ose.micro.postman.suppression.v1.Suppression
+	13, // 8: ose.micro.postman.suppression.v1.ReadRequest.request:type_name -> ose.micro.common.v1.Request
+	11, // 9: ose.micro.postman.suppression.v1.ReadResponse.result:type_name -> ose.micro.postman.suppression.v1.ReadResponse.ResultEntry
+	1,  // 10: ose.micro.postman.suppression.v1.CheckResponse.data:type_name -> ose.micro.postman.suppression.v1.Suppression
+	6,  // 11: ose.micro.postman.suppression.v1.ReadResponse.ResultEntry.value:type_name -> ose.micro.postman.suppression.v1.Suppressions
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_ose_micro_postman_suppression_v1_data_proto_init() }
+func file_ose_micro_postman_suppression_v1_data_proto_init() {
+	if File_ose_micro_postman_suppression_v1_data_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ose_micro_postman_suppression_v1_data_proto_rawDesc), len(file_ose_micro_postman_suppression_v1_data_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ose_micro_postman_suppression_v1_data_proto_goTypes,
+		DependencyIndexes: file_ose_micro_postman_suppression_v1_data_proto_depIdxs,
+		EnumInfos:         file_ose_micro_postman_suppression_v1_data_proto_enumTypes,
+		MessageInfos:      file_ose_micro_postman_suppression_v1_data_proto_msgTypes,
+	}.Build()
+	File_ose_micro_postman_suppression_v1_data_proto = out.File
+	file_ose_micro_postman_suppression_v1_data_proto_goTypes = nil
+	file_ose_micro_postman_suppression_v1_data_proto_depIdxs = nil
+}
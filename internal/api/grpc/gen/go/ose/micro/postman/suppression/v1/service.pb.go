@@ -0,0 +1,83 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: ose/micro/postman/suppression/v1/service.proto
+
+package suppressionv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_ose_micro_postman_suppression_v1_service_proto protoreflect.FileDescriptor
+
+const file_ose_micro_postman_suppression_v1_service_proto_rawDesc = "" +
+	"\n" +
+	".ose/micro/postman/suppression/v1/service.proto\x12 ose.micro.postman.suppression.v1\x1a+ose/micro/postman/suppression/v1/data.proto2\xb6\x03\n" +
+	"\x12SuppressionService\x12b\n" +
+	"\x03Add\x12,.ose.micro.postman.suppression.v1.AddRequest\x1a-.ose.micro.postman.suppression.v1.AddResponse\x12k\n" +
+	"\x06Remove\x12/.ose.micro.postman.suppression.v1.RemoveRequest\x1a0.ose.micro.postman.suppression.v1.RemoveResponse\x12e\n" +
+	"\x04Read\x12-.ose.micro.postman.suppression.v1.ReadRequest\x1a..ose.micro.postman.suppression.v1.ReadResponse\x12h\n" +
+	"\x05Check\x12..ose.micro.postman.suppression.v1.CheckRequest\x1a/.ose.micro.postman.suppression.v1.CheckResponseB\xbf\x02\n" +
+	"$com.ose.micro.postman.suppression.v1B\fServiceProtoP\x01Zdgithub.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/suppression/v1;suppressionv1\xa2\x02\x04OMPS\xaa\x02 Ose.Micro.Postman.Suppression.V1\xca\x02 Ose\\Micro\\Postman\\Suppression\\V1\xe2\x02,Ose\\Micro\\Postman\\Suppression\\V1\\GPBMetadata\xea\x02$Ose::Micro::Postman::Suppression::V1b\x06proto3"
+
+var file_ose_micro_postman_suppression_v1_service_proto_goTypes = []any{
+	(*AddRequest)(nil),     // 0: ose.micro.postman.suppression.v1.AddRequest
+	(*RemoveRequest)(nil),  // 1: ose.micro.postman.suppression.v1.RemoveRequest
+	(*ReadRequest)(nil),    // 2: ose.micro.postman.suppression.v1.ReadRequest
+	(*CheckRequest)(nil),   // 3: ose.micro.postman.suppression.v1.CheckRequest
+	(*AddResponse)(nil),    // 4: ose.micro.postman.suppression.v1.AddResponse
+	(*RemoveResponse)(nil), // 5: ose.micro.postman.suppression.v1.RemoveResponse
+	(*ReadResponse)(nil),   // 6: ose.micro.postman.suppression.v1.ReadResponse
+	(*CheckResponse)(nil),  // 7: ose.micro.postman.suppression.v1.CheckResponse
+}
+var file_ose_micro_postman_suppression_v1_service_proto_depIdxs = []int32{
+	0, // 0: ose.micro.postman.suppression.v1.SuppressionService.Add:input_type -> ose.micro.postman.suppression.v1.AddRequest
+	1, // 1: ose.micro.postman.suppression.v1.SuppressionService.Remove:input_type -> ose.micro.postman.suppression.v1.RemoveRequest
+	2, // 2: ose.micro.postman.suppression.v1.SuppressionService.Read:input_type -> ose.micro.postman.suppression.v1.ReadRequest
+	3, // 3: ose.micro.postman.suppression.v1.SuppressionService.Check:input_type -> ose.micro.postman.suppression.v1.CheckRequest
+	4, // 4: ose.micro.postman.suppression.v1.SuppressionService.Add:output_type -> ose.micro.postman.suppression.v1.AddResponse
+	5, // 5: ose.micro.postman.suppression.v1.SuppressionService.Remove:output_type -> ose.micro.postman.suppression.v1.RemoveResponse
+	6, // 6: ose.micro.postman.suppression.v1.SuppressionService.Read:output_type -> ose.micro.postman.suppression.v1.ReadResponse
+	7, // 7: ose.micro.postman.suppression.v1.SuppressionService.Check:output_type -> ose.micro.postman.suppression.v1.CheckResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_ose_micro_postman_suppression_v1_service_proto_init() }
+func file_ose_micro_postman_suppression_v1_service_proto_init() {
+	if File_ose_micro_postman_suppression_v1_service_proto != nil {
+		return
+	}
+	file_ose_micro_postman_suppression_v1_data_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ose_micro_postman_suppression_v1_service_proto_rawDesc), len(file_ose_micro_postman_suppression_v1_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ose_micro_postman_suppression_v1_service_proto_goTypes,
+		DependencyIndexes: file_ose_micro_postman_suppression_v1_service_proto_depIdxs,
+	}.Build()
+	File_ose_micro_postman_suppression_v1_service_proto = out.File
+	file_ose_micro_postman_suppression_v1_service_proto_goTypes = nil
+	file_ose_micro_postman_suppression_v1_service_proto_depIdxs = nil
+}
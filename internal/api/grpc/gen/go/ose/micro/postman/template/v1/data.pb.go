@@ -24,14 +24,22 @@ const (
 )
 
 type Template struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Placeholders  []string               `protobuf:"bytes,4,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
-	Count         int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Subject string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Deprecated: same as html_content.
+	//
+	// Deprecated: Marked as deprecated in ose/micro/postman/template/v1/data.proto.
+	Content      string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Placeholders []string               `protobuf:"bytes,4,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
+	Count        int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version      int32                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	Key          string                 `protobuf:"bytes,9,opt,name=key,proto3" json:"key,omitempty"`
+	// Plain-text alternative to html_content. Derived from it when empty.
+	TextContent   string `protobuf:"bytes,10,opt,name=text_content,json=textContent,proto3" json:"text_content,omitempty"`
+	HtmlContent   string `protobuf:"bytes,11,opt,name=html_content,json=htmlContent,proto3" json:"html_content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -80,6 +88,7 @@ func (x *Template) GetSubject() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in ose/micro/postman/template/v1/data.proto.
 func (x *Template) GetContent() string {
 	if x != nil {
 		return x.Content
@@ -115,18 +124,166 @@ func (x *Template) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Template) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Template) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Template) GetTextContent() string {
+	if x != nil {
+		return x.TextContent
+	}
+	return ""
+}
+
+func (x *Template) GetHtmlContent() string {
+	if x != nil {
+		return x.HtmlContent
+	}
+	return ""
+}
+
+type Revision struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Template string                 `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	Version  int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Subject  string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Deprecated: same as html_content.
+	//
+	// Deprecated: Marked as deprecated in ose/micro/postman/template/v1/data.proto.
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Placeholders  []string               `protobuf:"bytes,6,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	TextContent   string                 `protobuf:"bytes,8,opt,name=text_content,json=textContent,proto3" json:"text_content,omitempty"`
+	HtmlContent   string                 `protobuf:"bytes,9,opt,name=html_content,json=htmlContent,proto3" json:"html_content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Revision) Reset() {
+	*x = Revision{}
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Revision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Revision) ProtoMessage() {}
+
+func (x *Revision) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Revision.ProtoReflect.Descriptor instead.
+func (*Revision) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Revision) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Revision) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *Revision) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Revision) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in ose/micro/postman/template/v1/data.proto.
+func (x *Revision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Revision) GetPlaceholders() []string {
+	if x != nil {
+		return x.Placeholders
+	}
+	return nil
+}
+
+func (x *Revision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Revision) GetTextContent() string {
+	if x != nil {
+		return x.TextContent
+	}
+	return ""
+}
+
+func (x *Revision) GetHtmlContent() string {
+	if x != nil {
+		return x.HtmlContent
+	}
+	return ""
+}
+
 type CreateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Placeholders  []string               `protobuf:"bytes,2,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
-	Subject       string                 `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: use html_content. Read when html_content is empty.
+	//
+	// Deprecated: Marked as deprecated in ose/micro/postman/template/v1/data.proto.
+	Content      string   `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Placeholders []string `protobuf:"bytes,2,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
+	Subject      string   `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Stable, human-readable identifier such as "auth.welcome".
+	Key string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// Optional plain-text body, derived from html_content when empty.
+	TextContent   string `protobuf:"bytes,5,opt,name=text_content,json=textContent,proto3" json:"text_content,omitempty"`
+	HtmlContent   string `protobuf:"bytes,6,opt,name=html_content,json=htmlContent,proto3" json:"html_content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateRequest) Reset() {
 	*x = CreateRequest{}
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[1]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -138,7 +295,7 @@ func (x *CreateRequest) String() string {
 func (*CreateRequest) ProtoMessage() {}
 
 func (x *CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[1]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -151,9 +308,10 @@ func (x *CreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{1}
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{2}
 }
 
+// Deprecated: Marked as deprecated in ose/micro/postman/template/v1/data.proto.
 func (x *CreateRequest) GetContent() string {
 	if x != nil {
 		return x.Content
@@ -175,6 +333,27 @@ func (x *CreateRequest) GetSubject() string {
 	return ""
 }
 
+func (x *CreateRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetTextContent() string {
+	if x != nil {
+		return x.TextContent
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetHtmlContent() string {
+	if x != nil {
+		return x.HtmlContent
+	}
+	return ""
+}
+
 type CreateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -185,7 +364,7 @@ type CreateResponse struct {
 
 func (x *CreateResponse) Reset() {
 	*x = CreateResponse{}
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[2]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -197,7 +376,7 @@ func (x *CreateResponse) String() string {
 func (*CreateResponse) ProtoMessage() {}
 
 func (x *CreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[2]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -210,7 +389,7 @@ func (x *CreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
 func (*CreateResponse) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{2}
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateResponse) GetMessage() string {
@@ -236,7 +415,7 @@ type Templates struct {
 
 func (x *Templates) Reset() {
 	*x = Templates{}
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[3]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -248,7 +427,7 @@ func (x *Templates) String() string {
 func (*Templates) ProtoMessage() {}
 
 func (x *Templates) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[3]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,7 +440,7 @@ func (x *Templates) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Templates.ProtoReflect.Descriptor instead.
 func (*Templates) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{3}
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Templates) GetData() []*Template {
@@ -280,7 +459,7 @@ type ReadRequest struct {
 
 func (x *ReadRequest) Reset() {
 	*x = ReadRequest{}
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[4]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -292,7 +471,7 @@ func (x *ReadRequest) String() string {
 func (*ReadRequest) ProtoMessage() {}
 
 func (x *ReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[4]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -305,7 +484,7 @@ func (x *ReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadRequest.ProtoReflect.Descriptor instead.
 func (*ReadRequest) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{4}
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ReadRequest) GetRequest() *v1.Request {
@@ -324,7 +503,7 @@ type ReadResponse struct {
 
 func (x *ReadResponse) Reset() {
 	*x = ReadResponse{}
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[5]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -336,7 +515,7 @@ func (x *ReadResponse) String() string {
 func (*ReadResponse) ProtoMessage() {}
 
 func (x *ReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[5]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,7 +528,7 @@ func (x *ReadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadResponse.ProtoReflect.Descriptor instead.
 func (*ReadResponse) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{5}
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ReadResponse) GetResult() map[string]*Templates {
@@ -360,18 +539,25 @@ func (x *ReadResponse) GetResult() map[string]*Templates {
 }
 
 type UpdateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Placeholders  []string               `protobuf:"bytes,3,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
-	Subject       string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: use html_content. Read when html_content is empty.
+	//
+	// Deprecated: Marked as deprecated in ose/micro/postman/template/v1/data.proto.
+	Content      string   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Placeholders []string `protobuf:"bytes,3,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
+	Subject      string   `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Replaces the key; empty clears it.
+	Key           string `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	TextContent   string `protobuf:"bytes,6,opt,name=text_content,json=textContent,proto3" json:"text_content,omitempty"`
+	HtmlContent   string `protobuf:"bytes,7,opt,name=html_content,json=htmlContent,proto3" json:"html_content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateRequest) Reset() {
 	*x = UpdateRequest{}
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[6]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -383,7 +569,7 @@ func (x *UpdateRequest) String() string {
 func (*UpdateRequest) ProtoMessage() {}
 
 func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[6]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,7 +582,7 @@ func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{6}
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateRequest) GetId() string {
@@ -406,6 +592,7 @@ func (x *UpdateRequest) GetId() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in ose/micro/postman/template/v1/data.proto.
 func (x *UpdateRequest) GetContent() string {
 	if x != nil {
 		return x.Content
@@ -427,6 +614,27 @@ func (x *UpdateRequest) GetSubject() string {
 	return ""
 }
 
+func (x *UpdateRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetTextContent() string {
+	if x != nil {
+		return x.TextContent
+	}
+	return ""
+}
+
+func (x *UpdateRequest) GetHtmlContent() string {
+	if x != nil {
+		return x.HtmlContent
+	}
+	return ""
+}
+
 type UpdateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
@@ -436,7 +644,7 @@ type UpdateResponse struct {
 
 func (x *UpdateResponse) Reset() {
 	*x = UpdateResponse{}
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[7]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -448,7 +656,7 @@ func (x *UpdateResponse) String() string {
 func (*UpdateResponse) ProtoMessage() {}
 
 func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[7]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +669,7 @@ func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
 func (*UpdateResponse) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{7}
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateResponse) GetMessage() string {
@@ -480,7 +688,7 @@ type DeleteRequest struct {
 
 func (x *DeleteRequest) Reset() {
 	*x = DeleteRequest{}
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[8]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -492,7 +700,7 @@ func (x *DeleteRequest) String() string {
 func (*DeleteRequest) ProtoMessage() {}
 
 func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[8]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,7 +713,7 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{8}
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteRequest) GetId() string {
@@ -525,7 +733,7 @@ type DeleteResponse struct {
 
 func (x *DeleteResponse) Reset() {
 	*x = DeleteResponse{}
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[9]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -537,7 +745,7 @@ func (x *DeleteResponse) String() string {
 func (*DeleteResponse) ProtoMessage() {}
 
 func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[9]
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +758,7 @@ func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{9}
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteResponse) GetMessage() string {
@@ -567,49 +775,573 @@ func (x *DeleteResponse) GetRecord() *Template {
 	return nil
 }
 
-var File_ose_micro_postman_template_v1_data_proto protoreflect.FileDescriptor
+type ListRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Skip          int64                  `protobuf:"varint,2,opt,name=skip,proto3" json:"skip,omitempty"`
+	Limit         int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_ose_micro_postman_template_v1_data_proto_rawDesc = "" +
-	"\n" +
-	"(ose/micro/postman/template/v1/data.proto\x12\x1dose.micro.postman.template.v1\x1a!ose/micro/common/v1/request.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfe\x01\n" +
-	"\bTemplate\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
-	"\asubject\x18\x02 \x01(\tR\asubject\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\x12\"\n" +
-	"\fplaceholders\x18\x04 \x03(\tR\fplaceholders\x12\x14\n" +
-	"\x05count\x18\x05 \x01(\x05R\x05count\x129\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"g\n" +
-	"\rCreateRequest\x12\x18\n" +
-	"\acontent\x18\x01 \x01(\tR\acontent\x12\"\n" +
-	"\fplaceholders\x18\x02 \x03(\tR\fplaceholders\x12\x18\n" +
-	"\asubject\x18\x03 \x01(\tR\asubject\"k\n" +
-	"\x0eCreateResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\x12?\n" +
-	"\x06record\x18\x02 \x01(\v2'.ose.micro.postman.template.v1.TemplateR\x06record\"H\n" +
-	"\tTemplates\x12;\n" +
-	"\x04data\x18\x01 \x03(\v2'.ose.micro.postman.template.v1.TemplateR\x04data\"E\n" +
-	"\vReadRequest\x126\n" +
-	"\arequest\x18\x01 \x01(\v2\x1c.ose.micro.common.v1.RequestR\arequest\"\xc4\x01\n" +
-	"\fReadResponse\x12O\n" +
-	"\x06result\x18\x01 \x03(\v27.ose.micro.postman.template.v1.ReadResponse.ResultEntryR\x06result\x1ac\n" +
-	"\vResultEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12>\n" +
-	"\x05value\x18\x02 \x01(\v2(.ose.micro.postman.template.v1.TemplatesR\x05value:\x028\x01\"w\n" +
-	"\rUpdateRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\x12\"\n" +
-	"\fplaceholders\x18\x03 \x03(\tR\fplaceholders\x12\x18\n" +
-	"\asubject\x18\x04 \x01(\tR\asubject\"*\n" +
-	"\x0eUpdateResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\x1f\n" +
-	"\rDeleteRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"k\n" +
-	"\x0eDeleteResponse\x12\x18\n" +
+func (x *ListRevisionsRequest) Reset() {
+	*x = ListRevisionsRequest{}
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisionsRequest) ProtoMessage() {}
+
+func (x *ListRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListRevisionsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListRevisionsRequest) GetSkip() int64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *ListRevisionsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Revision            `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRevisionsResponse) Reset() {
+	*x = ListRevisionsResponse{}
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevisionsResponse) ProtoMessage() {}
+
+func (x *ListRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListRevisionsResponse) GetData() []*Revision {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevisionRequest) Reset() {
+	*x = GetRevisionRequest{}
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevisionRequest) ProtoMessage() {}
+
+func (x *GetRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetRevisionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetRevisionRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        *Revision              `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevisionResponse) Reset() {
+	*x = GetRevisionResponse{}
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevisionResponse) ProtoMessage() {}
+
+func (x *GetRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevisionResponse.ProtoReflect.Descriptor instead.
+func (*GetRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetRevisionResponse) GetRecord() *Revision {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type RollbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackRequest) Reset() {
+	*x = RollbackRequest{}
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackRequest) ProtoMessage() {}
+
+func (x *RollbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackRequest.ProtoReflect.Descriptor instead.
+func (*RollbackRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RollbackRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RollbackRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RollbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Record        *Template              `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackResponse) Reset() {
+	*x = RollbackResponse{}
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackResponse) ProtoMessage() {}
+
+func (x *RollbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackResponse.ProtoReflect.Descriptor instead.
+func (*RollbackResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RollbackResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RollbackResponse) GetRecord() *Template {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type RenderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Template id or key. When empty, html_content and subject are rendered as given.
+	Template string `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// Deprecated: use html_content. Read when html_content is empty.
+	//
+	// Deprecated: Marked as deprecated in ose/micro/postman/template/v1/data.proto.
+	Content       string            `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Subject       string            `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Data          map[string]string `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TextContent   string            `protobuf:"bytes,5,opt,name=text_content,json=textContent,proto3" json:"text_content,omitempty"`
+	HtmlContent   string            `protobuf:"bytes,6,opt,name=html_content,json=htmlContent,proto3" json:"html_content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderRequest) Reset() {
+	*x = RenderRequest{}
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderRequest) ProtoMessage() {}
+
+func (x *RenderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderRequest.ProtoReflect.Descriptor instead.
+func (*RenderRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RenderRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in ose/micro/postman/template/v1/data.proto.
+func (x *RenderRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *RenderRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *RenderRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *RenderRequest) GetTextContent() string {
+	if x != nil {
+		return x.TextContent
+	}
+	return ""
+}
+
+func (x *RenderRequest) GetHtmlContent() string {
+	if x != nil {
+		return x.HtmlContent
+	}
+	return ""
+}
+
+type RenderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Html          string                 `protobuf:"bytes,2,opt,name=html,proto3" json:"html,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Missing       []string               `protobuf:"bytes,4,rep,name=missing,proto3" json:"missing,omitempty"`
+	Unused        []string               `protobuf:"bytes,5,rep,name=unused,proto3" json:"unused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderResponse) Reset() {
+	*x = RenderResponse{}
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderResponse) ProtoMessage() {}
+
+func (x *RenderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_template_v1_data_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderResponse.ProtoReflect.Descriptor instead.
+func (*RenderResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_template_v1_data_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RenderResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *RenderResponse) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+func (x *RenderResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *RenderResponse) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *RenderResponse) GetUnused() []string {
+	if x != nil {
+		return x.Unused
+	}
+	return nil
+}
+
+var File_ose_micro_postman_template_v1_data_proto protoreflect.FileDescriptor
+
+const file_ose_micro_postman_template_v1_data_proto_rawDesc = "" +
+	"\n" +
+	"(ose/micro/postman/template/v1/data.proto\x12\x1dose.micro.postman.template.v1\x1a!ose/micro/common/v1/request.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf4\x02\n" +
+	"\bTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12\x1c\n" +
+	"\acontent\x18\x03 \x01(\tB\x02\x18\x01R\acontent\x12\"\n" +
+	"\fplaceholders\x18\x04 \x03(\tR\fplaceholders\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x05R\x05count\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\x05R\aversion\x12\x10\n" +
+	"\x03key\x18\t \x01(\tR\x03key\x12!\n" +
+	"\ftext_content\x18\n" +
+	" \x01(\tR\vtextContent\x12!\n" +
+	"\fhtml_content\x18\v \x01(\tR\vhtmlContent\"\xad\x02\n" +
+	"\bRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\btemplate\x18\x02 \x01(\tR\btemplate\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x1c\n" +
+	"\acontent\x18\x05 \x01(\tB\x02\x18\x01R\acontent\x12\"\n" +
+	"\fplaceholders\x18\x06 \x03(\tR\fplaceholders\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\ftext_content\x18\b \x01(\tR\vtextContent\x12!\n" +
+	"\fhtml_content\x18\t \x01(\tR\vhtmlContent\"\xc3\x01\n" +
+	"\rCreateRequest\x12\x1c\n" +
+	"\acontent\x18\x01 \x01(\tB\x02\x18\x01R\acontent\x12\"\n" +
+	"\fplaceholders\x18\x02 \x03(\tR\fplaceholders\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\x12!\n" +
+	"\ftext_content\x18\x05 \x01(\tR\vtextContent\x12!\n" +
+	"\fhtml_content\x18\x06 \x01(\tR\vhtmlContent\"k\n" +
+	"\x0eCreateResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12?\n" +
+	"\x06record\x18\x02 \x01(\v2'.ose.micro.postman.template.v1.TemplateR\x06record\"H\n" +
+	"\tTemplates\x12;\n" +
+	"\x04data\x18\x01 \x03(\v2'.ose.micro.postman.template.v1.TemplateR\x04data\"E\n" +
+	"\vReadRequest\x126\n" +
+	"\arequest\x18\x01 \x01(\v2\x1c.ose.micro.common.v1.RequestR\arequest\"\xc4\x01\n" +
+	"\fReadResponse\x12O\n" +
+	"\x06result\x18\x01 \x03(\v27.ose.micro.postman.template.v1.ReadResponse.ResultEntryR\x06result\x1ac\n" +
+	"\vResultEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12>\n" +
+	"\x05value\x18\x02 \x01(\v2(.ose.micro.postman.template.v1.TemplatesR\x05value:\x028\x01\"\xd3\x01\n" +
+	"\rUpdateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\acontent\x18\x02 \x01(\tB\x02\x18\x01R\acontent\x12\"\n" +
+	"\fplaceholders\x18\x03 \x03(\tR\fplaceholders\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x10\n" +
+	"\x03key\x18\x05 \x01(\tR\x03key\x12!\n" +
+	"\ftext_content\x18\x06 \x01(\tR\vtextContent\x12!\n" +
+	"\fhtml_content\x18\a \x01(\tR\vhtmlContent\"*\n" +
+	"\x0eUpdateResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x1f\n" +
+	"\rDeleteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"k\n" +
+	"\x0eDeleteResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12?\n" +
+	"\x06record\x18\x02 \x01(\v2'.ose.micro.postman.template.v1.TemplateR\x06record\"P\n" +
+	"\x14ListRevisionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04skip\x18\x02 \x01(\x03R\x04skip\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\"T\n" +
+	"\x15ListRevisionsResponse\x12;\n" +
+	"\x04data\x18\x01 \x03(\v2'.ose.micro.postman.template.v1.RevisionR\x04data\">\n" +
+	"\x12GetRevisionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"V\n" +
+	"\x13GetRevisionResponse\x12?\n" +
+	"\x06record\x18\x01 \x01(\v2'.ose.micro.postman.template.v1.RevisionR\x06record\";\n" +
+	"\x0fRollbackRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"m\n" +
+	"\x10RollbackResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12?\n" +
-	"\x06record\x18\x02 \x01(\v2'.ose.micro.postman.template.v1.TemplateR\x06recordB\xa7\x02\n" +
+	"\x06record\x18\x02 \x01(\v2'.ose.micro.postman.template.v1.TemplateR\x06record\"\xae\x02\n" +
+	"\rRenderRequest\x12\x1a\n" +
+	"\btemplate\x18\x01 \x01(\tR\btemplate\x12\x1c\n" +
+	"\acontent\x18\x02 \x01(\tB\x02\x18\x01R\acontent\x12\x18\n" +
+	"\asubject\x18\x03 \x01(\tR\asubject\x12J\n" +
+	"\x04data\x18\x04 \x03(\v26.ose.micro.postman.template.v1.RenderRequest.DataEntryR\x04data\x12!\n" +
+	"\ftext_content\x18\x05 \x01(\tR\vtextContent\x12!\n" +
+	"\fhtml_content\x18\x06 \x01(\tR\vhtmlContent\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x84\x01\n" +
+	"\x0eRenderResponse\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\x12\x12\n" +
+	"\x04html\x18\x02 \x01(\tR\x04html\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x18\n" +
+	"\amissing\x18\x04 \x03(\tR\amissing\x12\x16\n" +
+	"\x06unused\x18\x05 \x03(\tR\x06unusedB\xa7\x02\n" +
 	"!com.ose.micro.postman.template.v1B\tDataProtoP\x01Z^github.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/template/v1;templatev1\xa2\x02\x04OMPT\xaa\x02\x1dOse.Micro.Postman.Template.V1\xca\x02\x1dOse\\Micro\\Postman\\Template\\V1\xe2\x02)Ose\\Micro\\Postman\\Template\\V1\\GPBMetadata\xea\x02!Ose::Micro::Postman::Template::V1b\x06proto3"
 
 var (
@@ -624,36 +1356,51 @@ func file_ose_micro_postman_template_v1_data_proto_rawDescGZIP() []byte {
 	return file_ose_micro_postman_template_v1_data_proto_rawDescData
 }
 
-var file_ose_micro_postman_template_v1_data_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_ose_micro_postman_template_v1_data_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_ose_micro_postman_template_v1_data_proto_goTypes = []any{
 	(*Template)(nil),              // 0: ose.micro.postman.template.v1.Template
-	(*CreateRequest)(nil),         // 1: ose.micro.postman.template.v1.CreateRequest
-	(*CreateResponse)(nil),        // 2: ose.micro.postman.template.v1.CreateResponse
-	(*Templates)(nil),             // 3: ose.micro.postman.template.v1.Templates
-	(*ReadRequest)(nil),           // 4: ose.micro.postman.template.v1.ReadRequest
-	(*ReadResponse)(nil),          // 5: ose.micro.postman.template.v1.ReadResponse
-	(*UpdateRequest)(nil),         // 6: ose.micro.postman.template.v1.UpdateRequest
-	(*UpdateResponse)(nil),        // 7: ose.micro.postman.template.v1.UpdateResponse
-	(*DeleteRequest)(nil),         // 8: ose.micro.postman.template.v1.DeleteRequest
-	(*DeleteResponse)(nil),        // 9: ose.micro.postman.template.v1.DeleteResponse
-	nil,                           // 10: ose.micro.postman.template.v1.ReadResponse.ResultEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
-	(*v1.Request)(nil),            // 12: ose.micro.common.v1.Request
+	(*Revision)(nil),              // 1: ose.micro.postman.template.v1.Revision
+	(*CreateRequest)(nil),         // 2: ose.micro.postman.template.v1.CreateRequest
+	(*CreateResponse)(nil),        // 3: ose.micro.postman.template.v1.CreateResponse
+	(*Templates)(nil),             // 4: ose.micro.postman.template.v1.Templates
+	(*ReadRequest)(nil),           // 5: ose.micro.postman.template.v1.ReadRequest
+	(*ReadResponse)(nil),          // 6: ose.micro.postman.template.v1.ReadResponse
+	(*UpdateRequest)(nil),         // 7: ose.micro.postman.template.v1.UpdateRequest
+	(*UpdateResponse)(nil),        // 8: ose.micro.postman.template.v1.UpdateResponse
+	(*DeleteRequest)(nil),         // 9: ose.micro.postman.template.v1.DeleteRequest
+	(*DeleteResponse)(nil),        // 10: ose.micro.postman.template.v1.DeleteResponse
+	(*ListRevisionsRequest)(nil),  // 11: ose.micro.postman.template.v1.ListRevisionsRequest
+	(*ListRevisionsResponse)(nil), // 12: ose.micro.postman.template.v1.ListRevisionsResponse
+	(*GetRevisionRequest)(nil),    // 13: ose.micro.postman.template.v1.GetRevisionRequest
+	(*GetRevisionResponse)(nil),   // 14: ose.micro.postman.template.v1.GetRevisionResponse
+	(*RollbackRequest)(nil),       // 15: ose.micro.postman.template.v1.RollbackRequest
+	(*RollbackResponse)(nil),      // 16: ose.micro.postman.template.v1.RollbackResponse
+	(*RenderRequest)(nil),         // 17: ose.micro.postman.template.v1.RenderRequest
+	(*RenderResponse)(nil),        // 18: ose.micro.postman.template.v1.RenderResponse
+	nil,                           // 19: ose.micro.postman.template.v1.ReadResponse.ResultEntry
+	nil,                           // 20: ose.micro.postman.template.v1.RenderRequest.DataEntry
+	(*timestamppb.Timestamp)(nil), // 21: google.protobuf.Timestamp
+	(*v1.Request)(nil),            // 22: ose.micro.common.v1.Request
 }
 var file_ose_micro_postman_template_v1_data_proto_depIdxs = []int32{
-	11, // 0: ose.micro.postman.template.v1.Template.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: ose.micro.postman.template.v1.Template.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: ose.micro.postman.template.v1.CreateResponse.record:type_name -> ose.micro.postman.template.v1.Template
-	0,  // 3: ose.micro.postman.template.v1.Templates.data:type_name -> ose.micro.postman.template.v1.Template
-	12, // 4: ose.micro.postman.template.v1.ReadRequest.request:type_name -> ose.micro.common.v1.Request
-	10, // 5: ose.micro.postman.template.v1.ReadResponse.result:type_name -> ose.micro.postman.template.v1.ReadResponse.ResultEntry
-	0,  // 6: ose.micro.postman.template.v1.DeleteResponse.record:type_name -> ose.micro.postman.template.v1.Template
-	3,  // 7: ose.micro.postman.template.v1.ReadResponse.ResultEntry.value:type_name -> ose.micro.postman.template.v1.Templates
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	21, // 0: ose.micro.postman.template.v1.Template.created_at:type_name -> google.protobuf.Timestamp
+	21, // 1: ose.micro.postman.template.v1.Template.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 2: ose.micro.postman.template.v1.Revision.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: ose.micro.postman.template.v1.CreateResponse.record:type_name -> ose.micro.postman.template.v1.Template
+	0,  // 4: ose.micro.postman.template.v1.Templates.data:type_name -> ose.micro.postman.template.v1.Template
+	22, // 5: ose.micro.postman.template.v1.ReadRequest.request:type_name -> ose.micro.common.v1.Request
+	19, // 6: ose.micro.postman.template.v1.ReadResponse.result:type_name -> ose.micro.postman.template.v1.ReadResponse.ResultEntry
+	0,  // 7: ose.micro.postman.template.v1.DeleteResponse.record:type_name -> ose.micro.postman.template.v1.Template
+	1,  // 8: ose.micro.postman.template.v1.ListRevisionsResponse.data:type_name -> ose.micro.postman.template.v1.Revision
+	1,  // 9: ose.micro.postman.template.v1.GetRevisionResponse.record:type_name -> ose.micro.postman.template.v1.Revision
+	0,  // 10: ose.micro.postman.template.v1.RollbackResponse.record:type_name -> ose.micro.postman.template.v1.Template
+	20, // 11: ose.micro.postman.template.v1.RenderRequest.data:type_name -> ose.micro.postman.template.v1.RenderRequest.DataEntry
+	4,  // 12: ose.micro.postman.template.v1.ReadResponse.ResultEntry.value:type_name -> ose.micro.postman.template.v1.Templates
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_ose_micro_postman_template_v1_data_proto_init() }
@@ -667,7 +1414,7 @@ func file_ose_micro_postman_template_v1_data_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ose_micro_postman_template_v1_data_proto_rawDesc), len(file_ose_micro_postman_template_v1_data_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
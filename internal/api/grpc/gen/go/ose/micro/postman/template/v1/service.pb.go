@@ -24,38 +24,58 @@ var File_ose_micro_postman_template_v1_service_proto protoreflect.FileDescriptor
 
 const file_ose_micro_postman_template_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"+ose/micro/postman/template/v1/service.proto\x12\x1dose.micro.postman.template.v1\x1a(ose/micro/postman/template/v1/data.proto2\xa7\x03\n" +
+	"+ose/micro/postman/template/v1/service.proto\x12\x1dose.micro.postman.template.v1\x1a(ose/micro/postman/template/v1/data.proto2\xed\x06\n" +
 	"\x0fTemplateService\x12e\n" +
 	"\x06Create\x12,.ose.micro.postman.template.v1.CreateRequest\x1a-.ose.micro.postman.template.v1.CreateResponse\x12_\n" +
 	"\x04Read\x12*.ose.micro.postman.template.v1.ReadRequest\x1a+.ose.micro.postman.template.v1.ReadResponse\x12e\n" +
 	"\x06Update\x12,.ose.micro.postman.template.v1.UpdateRequest\x1a-.ose.micro.postman.template.v1.UpdateResponse\x12e\n" +
-	"\x06Delete\x12,.ose.micro.postman.template.v1.DeleteRequest\x1a-.ose.micro.postman.template.v1.DeleteResponseB\xaa\x02\n" +
+	"\x06Delete\x12,.ose.micro.postman.template.v1.DeleteRequest\x1a-.ose.micro.postman.template.v1.DeleteResponse\x12z\n" +
+	"\rListRevisions\x123.ose.micro.postman.template.v1.ListRevisionsRequest\x1a4.ose.micro.postman.template.v1.ListRevisionsResponse\x12t\n" +
+	"\vGetRevision\x121.ose.micro.postman.template.v1.GetRevisionRequest\x1a2.ose.micro.postman.template.v1.GetRevisionResponse\x12k\n" +
+	"\bRollback\x12..ose.micro.postman.template.v1.RollbackRequest\x1a/.ose.micro.postman.template.v1.RollbackResponse\x12e\n" +
+	"\x06Render\x12,.ose.micro.postman.template.v1.RenderRequest\x1a-.ose.micro.postman.template.v1.RenderResponseB\xaa\x02\n" +
 	"!com.ose.micro.postman.template.v1B\fServiceProtoP\x01Z^github.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/template/v1;templatev1\xa2\x02\x04OMPT\xaa\x02\x1dOse.Micro.Postman.Template.V1\xca\x02\x1dOse\\Micro\\Postman\\Template\\V1\xe2\x02)Ose\\Micro\\Postman\\Template\\V1\\GPBMetadata\xea\x02!Ose::Micro::Postman::Template::V1b\x06proto3"
 
 var file_ose_micro_postman_template_v1_service_proto_goTypes = []any{
-	(*CreateRequest)(nil),  // 0: ose.micro.postman.template.v1.CreateRequest
-	(*ReadRequest)(nil),    // 1: ose.micro.postman.template.v1.ReadRequest
-	(*UpdateRequest)(nil),  // 2: ose.micro.postman.template.v1.UpdateRequest
-	(*DeleteRequest)(nil),  // 3: ose.micro.postman.template.v1.DeleteRequest
-	(*CreateResponse)(nil), // 4: ose.micro.postman.template.v1.CreateResponse
-	(*ReadResponse)(nil),   // 5: ose.micro.postman.template.v1.ReadResponse
-	(*UpdateResponse)(nil), // 6: ose.micro.postman.template.v1.UpdateResponse
-	(*DeleteResponse)(nil), // 7: ose.micro.postman.template.v1.DeleteResponse
+	(*CreateRequest)(nil),         // 0: ose.micro.postman.template.v1.CreateRequest
+	(*ReadRequest)(nil),           // 1: ose.micro.postman.template.v1.ReadRequest
+	(*UpdateRequest)(nil),         // 2: ose.micro.postman.template.v1.UpdateRequest
+	(*DeleteRequest)(nil),         // 3: ose.micro.postman.template.v1.DeleteRequest
+	(*ListRevisionsRequest)(nil),  // 4: ose.micro.postman.template.v1.ListRevisionsRequest
+	(*GetRevisionRequest)(nil),    // 5: ose.micro.postman.template.v1.GetRevisionRequest
+	(*RollbackRequest)(nil),       // 6: ose.micro.postman.template.v1.RollbackRequest
+	(*RenderRequest)(nil),         // 7: ose.micro.postman.template.v1.RenderRequest
+	(*CreateResponse)(nil),        // 8: ose.micro.postman.template.v1.CreateResponse
+	(*ReadResponse)(nil),          // 9: ose.micro.postman.template.v1.ReadResponse
+	(*UpdateResponse)(nil),        // 10: ose.micro.postman.template.v1.UpdateResponse
+	(*DeleteResponse)(nil),        // 11: ose.micro.postman.template.v1.DeleteResponse
+	(*ListRevisionsResponse)(nil), // 12: ose.micro.postman.template.v1.ListRevisionsResponse
+	(*GetRevisionResponse)(nil),   // 13: ose.micro.postman.template.v1.GetRevisionResponse
+	(*RollbackResponse)(nil),      // 14: ose.micro.postman.template.v1.RollbackResponse
+	(*RenderResponse)(nil),        // 15: ose.micro.postman.template.v1.RenderResponse
 }
 var file_ose_micro_postman_template_v1_service_proto_depIdxs = []int32{
-	0, // 0: ose.micro.postman.template.v1.TemplateService.Create:input_type -> ose.micro.postman.template.v1.CreateRequest
-	1, // 1: ose.micro.postman.template.v1.TemplateService.Read:input_type -> ose.micro.postman.template.v1.ReadRequest
-	2, // 2: ose.micro.postman.template.v1.TemplateService.Update:input_type -> ose.micro.postman.template.v1.UpdateRequest
-	3, // 3: ose.micro.postman.template.v1.TemplateService.Delete:input_type -> ose.micro.postman.template.v1.DeleteRequest
-	4, // 4: ose.micro.postman.template.v1.TemplateService.Create:output_type -> ose.micro.postman.template.v1.CreateResponse
-	5, // 5: ose.micro.postman.template.v1.TemplateService.Read:output_type -> ose.micro.postman.template.v1.ReadResponse
-	6, // 6: ose.micro.postman.template.v1.TemplateService.Update:output_type -> ose.micro.postman.template.v1.UpdateResponse
-	7, // 7: ose.micro.postman.template.v1.TemplateService.Delete:output_type -> ose.micro.postman.template.v1.DeleteResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: ose.micro.postman.template.v1.TemplateService.Create:input_type -> ose.micro.postman.template.v1.CreateRequest
+	1,  // 1: ose.micro.postman.template.v1.TemplateService.Read:input_type -> ose.micro.postman.template.v1.ReadRequest
+	2,  // 2: ose.micro.postman.template.v1.TemplateService.Update:input_type -> ose.micro.postman.template.v1.UpdateRequest
+	3,  // 3: ose.micro.postman.template.v1.TemplateService.Delete:input_type -> ose.micro.postman.template.v1.DeleteRequest
+	4,  // 4: ose.micro.postman.template.v1.TemplateService.ListRevisions:input_type -> ose.micro.postman.template.v1.ListRevisionsRequest
+	5,  // 5: ose.micro.postman.template.v1.TemplateService.GetRevision:input_type -> ose.micro.postman.template.v1.GetRevisionRequest
+	6,  // 6: ose.micro.postman.template.v1.TemplateService.Rollback:input_type -> ose.micro.postman.template.v1.RollbackRequest
+	7,  // 7: ose.micro.postman.template.v1.TemplateService.Render:input_type -> ose.micro.postman.template.v1.RenderRequest
+	8,  // 8: ose.micro.postman.template.v1.TemplateService.Create:output_type -> ose.micro.postman.template.v1.CreateResponse
+	9,  // 9: ose.micro.postman.template.v1.TemplateService.Read:output_type -> ose.micro.postman.template.v1.ReadResponse
+	10, // 10: ose.micro.postman.template.v1.TemplateService.Update:output_type -> ose.micro.postman.template.v1.UpdateResponse
+	11, // 11: ose.micro.postman.template.v1.TemplateService.Delete:output_type -> ose.micro.postman.template.v1.DeleteResponse
+	12, // 12: ose.micro.postman.template.v1.TemplateService.ListRevisions:output_type -> ose.micro.postman.template.v1.ListRevisionsResponse
+	13, // 13: ose.micro.postman.template.v1.TemplateService.GetRevision:output_type -> ose.micro.postman.template.v1.GetRevisionResponse
+	14, // 14: ose.micro.postman.template.v1.TemplateService.Rollback:output_type -> ose.micro.postman.template.v1.RollbackResponse
+	15, // 15: ose.micro.postman.template.v1.TemplateService.Render:output_type -> ose.micro.postman.template.v1.RenderResponse
+	8,  // [8:16] is the sub-list for method output_type
+	0,  // [0:8] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_ose_micro_postman_template_v1_service_proto_init() }
@@ -0,0 +1,1054 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: ose/micro/postman/webhook/v1/data.proto
+
+package webhookv1
+
+import (
+	v1 "github.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeliveryState int32
+
+const (
+	DeliveryState_DeliveryStateUnknown   DeliveryState = 0
+	DeliveryState_DeliveryStatePending   DeliveryState = 1
+	DeliveryState_DeliveryStateDelivered DeliveryState = 2
+	DeliveryState_DeliveryStateFailed    DeliveryState = 3
+)
+
+// Enum value maps for DeliveryState.
+var (
+	DeliveryState_name = map[int32]string{
+		0: "DeliveryStateUnknown",
+		1: "DeliveryStatePending",
+		2: "DeliveryStateDelivered",
+		3: "DeliveryStateFailed",
+	}
+	DeliveryState_value = map[string]int32{
+		"DeliveryStateUnknown":   0,
+		"DeliveryStatePending":   1,
+		"DeliveryStateDelivered": 2,
+		"DeliveryStateFailed":    3,
+	}
+)
+
+func (x DeliveryState) Enum() *DeliveryState {
+	p := new(DeliveryState)
+	*p = x
+	return p
+}
+
+func (x DeliveryState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeliveryState) Descriptor() protoreflect.EnumDescriptor {
+	return file_ose_micro_postman_webhook_v1_data_proto_enumTypes[0].Descriptor()
+}
+
+func (DeliveryState) Type() protoreflect.EnumType {
+	return &file_ose_micro_postman_webhook_v1_data_proto_enumTypes[0]
+}
+
+func (x DeliveryState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryState.Descriptor instead.
+func (DeliveryState) EnumDescriptor() ([]byte, []int) {
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP(), []int{0}
+}
+
+type Subscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url   string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Event names such as email.delivered; empty receives every event.
+	Filter []string `protobuf:"bytes,3,rep,name=filter,proto3" json:"filter,omitempty"`
+	// Only emails tagged tenant=<tenant> are sent; empty receives all tenants.
+	Tenant        string                 `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Subscription) GetFilter() []string {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *Subscription) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *Subscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Delivery is one callback in the delivery log.
+type Delivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	EmailId        string                 `protobuf:"bytes,3,opt,name=email_id,json=emailId,proto3" json:"email_id,omitempty"`
+	Event          string                 `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	// JSON body posted to the subscriber.
+	Payload  string        `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	State    DeliveryState `protobuf:"varint,6,opt,name=state,proto3,enum=ose.micro.postman.webhook.v1.DeliveryState" json:"state,omitempty"`
+	Attempts int32         `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// HTTP status of the last attempt; zero when no response came back.
+	Status        int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	LastError     string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	DeliveredAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Delivery) Reset() {
+	*x = Delivery{}
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Delivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delivery) ProtoMessage() {}
+
+func (x *Delivery) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Delivery.ProtoReflect.Descriptor instead.
+func (*Delivery) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Delivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Delivery) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *Delivery) GetEmailId() string {
+	if x != nil {
+		return x.EmailId
+	}
+	return ""
+}
+
+func (x *Delivery) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *Delivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *Delivery) GetState() DeliveryState {
+	if x != nil {
+		return x.State
+	}
+	return DeliveryState_DeliveryStateUnknown
+}
+
+func (x *Delivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Delivery) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Delivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Delivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *Delivery) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *Delivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Delivery) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Signing secret; generated when empty.
+	Secret        string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Filter        []string `protobuf:"bytes,3,rep,name=filter,proto3" json:"filter,omitempty"`
+	Tenant        string   `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRequest) Reset() {
+	*x = CreateRequest{}
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRequest) ProtoMessage() {}
+
+func (x *CreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
+func (*CreateRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetFilter() []string {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+type CreateResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Message string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Record  *Subscription          `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	// The signing secret, only ever returned here.
+	Secret        string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateResponse) Reset() {
+	*x = CreateResponse{}
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResponse) ProtoMessage() {}
+
+func (x *CreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
+func (*CreateResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateResponse) GetRecord() *Subscription {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *CreateResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type DeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResponse) Reset() {
+	*x = DeleteResponse{}
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResponse) ProtoMessage() {}
+
+func (x *DeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Subscriptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Subscription        `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subscriptions) Reset() {
+	*x = Subscriptions{}
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscriptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscriptions) ProtoMessage() {}
+
+func (x *Subscriptions) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscriptions.ProtoReflect.Descriptor instead.
+func (*Subscriptions) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Subscriptions) GetData() []*Subscription {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *v1.Request            `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadRequest) Reset() {
+	*x = ReadRequest{}
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRequest) ProtoMessage() {}
+
+func (x *ReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRequest.ProtoReflect.Descriptor instead.
+func (*ReadRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReadRequest) GetRequest() *v1.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ReadResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Result        map[string]*Subscriptions `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadResponse) Reset() {
+	*x = ReadResponse{}
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadResponse) ProtoMessage() {}
+
+func (x *ReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadResponse.ProtoReflect.Descriptor instead.
+func (*ReadResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReadResponse) GetResult() map[string]*Subscriptions {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type Deliveries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Delivery            `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Deliveries) Reset() {
+	*x = Deliveries{}
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deliveries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deliveries) ProtoMessage() {}
+
+func (x *Deliveries) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deliveries.ProtoReflect.Descriptor instead.
+func (*Deliveries) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Deliveries) GetData() []*Delivery {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *v1.Request            `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveriesRequest) Reset() {
+	*x = DeliveriesRequest{}
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveriesRequest) ProtoMessage() {}
+
+func (x *DeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*DeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeliveriesRequest) GetRequest() *v1.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type DeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        map[string]*Deliveries `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveriesResponse) Reset() {
+	*x = DeliveriesResponse{}
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveriesResponse) ProtoMessage() {}
+
+func (x *DeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*DeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeliveriesResponse) GetResult() map[string]*Deliveries {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// ReplayRequest names one delivery by id, or a subscription whose failed
+// deliveries are all replayed.
+type ReplayRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReplayRequest) Reset() {
+	*x = ReplayRequest{}
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayRequest) ProtoMessage() {}
+
+func (x *ReplayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayRequest.ProtoReflect.Descriptor instead.
+func (*ReplayRequest) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReplayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReplayRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+type ReplayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Replayed      int64                  `protobuf:"varint,2,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayResponse) Reset() {
+	*x = ReplayResponse{}
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayResponse) ProtoMessage() {}
+
+func (x *ReplayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ose_micro_postman_webhook_v1_data_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayResponse.ProtoReflect.Descriptor instead.
+func (*ReplayResponse) Descriptor() ([]byte, []int) {
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReplayResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReplayResponse) GetReplayed() int64 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+var File_ose_micro_postman_webhook_v1_data_proto protoreflect.FileDescriptor
+
+const file_ose_micro_postman_webhook_v1_data_proto_rawDesc = "" +
+	"\n" +
+	"'ose/micro/postman/webhook/v1/data.proto\x12\x1cose.micro.postman.webhook.v1\x1a!ose/micro/common/v1/request.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x01\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06filter\x18\x03 \x03(\tR\x06filter\x12\x16\n" +
+	"\x06tenant\x18\x04 \x01(\tR\x06tenant\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9d\x04\n" +
+	"\bDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12\x19\n" +
+	"\bemail_id\x18\x03 \x01(\tR\aemailId\x12\x14\n" +
+	"\x05event\x18\x04 \x01(\tR\x05event\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12A\n" +
+	"\x05state\x18\x06 \x01(\x0e2+.ose.micro.postman.webhook.v1.DeliveryStateR\x05state\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12\x16\n" +
+	"\x06status\x18\b \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12B\n" +
+	"\x0fnext_attempt_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12=\n" +
+	"\fdelivered_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"i\n" +
+	"\rCreateRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06filter\x18\x03 \x03(\tR\x06filter\x12\x16\n" +
+	"\x06tenant\x18\x04 \x01(\tR\x06tenant\"\x86\x01\n" +
+	"\x0eCreateResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12B\n" +
+	"\x06record\x18\x02 \x01(\v2*.ose.micro.postman.webhook.v1.SubscriptionR\x06record\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\"\x1f\n" +
+	"\rDeleteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"*\n" +
+	"\x0eDeleteResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"O\n" +
+	"\rSubscriptions\x12>\n" +
+	"\x04data\x18\x01 \x03(\v2*.ose.micro.postman.webhook.v1.SubscriptionR\x04data\"E\n" +
+	"\vReadRequest\x126\n" +
+	"\arequest\x18\x01 \x01(\v2\x1c.ose.micro.common.v1.RequestR\arequest\"\xc6\x01\n" +
+	"\fReadResponse\x12N\n" +
+	"\x06result\x18\x01 \x03(\v26.ose.micro.postman.webhook.v1.ReadResponse.ResultEntryR\x06result\x1af\n" +
+	"\vResultEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12A\n" +
+	"\x05value\x18\x02 \x01(\v2+.ose.micro.postman.webhook.v1.SubscriptionsR\x05value:\x028\x01\"H\n" +
+	"\n" +
+	"Deliveries\x12:\n" +
+	"\x04data\x18\x01 \x03(\v2&.ose.micro.postman.webhook.v1.DeliveryR\x04data\"K\n" +
+	"\x11DeliveriesRequest\x126\n" +
+	"\arequest\x18\x01 \x01(\v2\x1c.ose.micro.common.v1.RequestR\arequest\"\xcf\x01\n" +
+	"\x12DeliveriesResponse\x12T\n" +
+	"\x06result\x18\x01 \x03(\v2<.ose.micro.postman.webhook.v1.DeliveriesResponse.ResultEntryR\x06result\x1ac\n" +
+	"\vResultEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12>\n" +
+	"\x05value\x18\x02 \x01(\v2(.ose.micro.postman.webhook.v1.DeliveriesR\x05value:\x028\x01\"H\n" +
+	"\rReplayRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\"F\n" +
+	"\x0eReplayResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1a\n" +
+	"\breplayed\x18\x02 \x01(\x03R\breplayed*x\n" +
+	"\rDeliveryState\x12\x18\n" +
+	"\x14DeliveryStateUnknown\x10\x00\x12\x18\n" +
+	"\x14DeliveryStatePending\x10\x01\x12\x1a\n" +
+	"\x16DeliveryStateDelivered\x10\x02\x12\x17\n" +
+	"\x13DeliveryStateFailed\x10\x03B\xa0\x02\n" +
+	" com.ose.micro.postman.webhook.v1B\tDataProtoP\x01Z\\github.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/webhook/v1;webhookv1\xa2\x02\x04OMPW\xaa\x02\x1cOse.Micro.Postman.Webhook.V1\xca\x02\x1cOse\\Micro\\Postman\\Webhook\\V1\xe2\x02(Ose\\Micro\\Postman\\Webhook\\V1\\GPBMetadata\xea\x02 Ose::Micro::Postman::Webhook::V1b\x06proto3"
+
+var (
+	file_ose_micro_postman_webhook_v1_data_proto_rawDescOnce sync.Once
+	file_ose_micro_postman_webhook_v1_data_proto_rawDescData []byte
+)
+
+func file_ose_micro_postman_webhook_v1_data_proto_rawDescGZIP() []byte {
+	file_ose_micro_postman_webhook_v1_data_proto_rawDescOnce.Do(func() {
+		file_ose_micro_postman_webhook_v1_data_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ose_micro_postman_webhook_v1_data_proto_rawDesc), len(file_ose_micro_postman_webhook_v1_data_proto_rawDesc)))
+	})
+	return file_ose_micro_postman_webhook_v1_data_proto_rawDescData
+}
+
+var file_ose_micro_postman_webhook_v1_data_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ose_micro_postman_webhook_v1_data_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_ose_micro_postman_webhook_v1_data_proto_goTypes = []any{
+	(DeliveryState)(0),            // 0: ose.micro.postman.webhook.v1.DeliveryState
+	(*Subscription)(nil),          // 1: ose.micro.postman.webhook.v1.Subscription
+	(*Delivery)(nil),              // 2: ose.micro.postman.webhook.v1.Delivery
+	(*CreateRequest)(nil),         // 3: ose.micro.postman.webhook.v1.CreateRequest
+	(*CreateResponse)(nil),        // 4: ose.micro.postman.webhook.v1.CreateResponse
+	(*DeleteRequest)(nil),         // 5: ose.micro.postman.webhook.v1.DeleteRequest
+	(*DeleteResponse)(nil),        // 6: ose.micro.postman.webhook.v1.DeleteResponse
+	(*Subscriptions)(nil),         // 7: ose.micro.postman.webhook.v1.Subscriptions
+	(*ReadRequest)(nil),           // 8: ose.micro.postman.webhook.v1.ReadRequest
+	(*ReadResponse)(nil),          // 9: ose.micro.postman.webhook.v1.ReadResponse
+	(*Deliveries)(nil),            // 10: ose.micro.postman.webhook.v1.Deliveries
+	(*DeliveriesRequest)(nil),     // 11: ose.micro.postman.webhook.v1.DeliveriesRequest
+	(*DeliveriesResponse)(nil),    // 12: ose.micro.postman.webhook.v1.DeliveriesResponse
+	(*ReplayRequest)(nil),         // 13: ose.micro.postman.webhook.v1.ReplayRequest
+	(*ReplayResponse)(nil),        // 14: ose.micro.postman.webhook.v1.ReplayResponse
+	nil,                           // 15: ose.micro.postman.webhook.v1.ReadResponse.ResultEntry
+	nil,                           // 16: ose.micro.postman.webhook.v1.DeliveriesResponse.ResultEntry
+	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
+	(*v1.Request)(nil),            // 18: ose.micro.common.v1.Request
+}
+var file_ose_micro_postman_webhook_v1_data_proto_depIdxs = []int32{
+	17, // 0: ose.micro.postman.webhook.v1.Subscription.created_at:type_name -> google.protobuf.Timestamp
+	17, // 1: ose.micro.postman.webhook.v1.Subscription.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: ose.micro.postman.webhook.v1.Delivery.state:type_name -> ose.micro.postman.webhook.v1.DeliveryState
+	17, // 3: ose.micro.postman.webhook.v1.Delivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	17, // 4: ose.micro.postman.webhook.v1.Delivery.delivered_at:type_name -> google.protobuf.Timestamp
+	17, // 5: ose.micro.postman.webhook.v1.Delivery.created_at:type_name -> google.protobuf.Timestamp
+	17, // 6: ose.micro.postman.webhook.v1.Delivery.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 7: ose.micro.postman.webhook.v1.CreateResponse.record:type_name -> ose.micro.postman.webhook.v1.Subscription
+	1,  // 8: ose.micro.postman.webhook.v1.Subscriptions.data:type_name -> ose.micro.postman.webhook.v1.Subscription
+	18, // 9: ose.micro.postman.webhook.v1.ReadRequest.request:type_name -> ose.micro.common.v1.Request
+	15, // 10: ose.micro.postman.webhook.v1.ReadResponse.result:type_name -> ose.micro.postman.webhook.v1.ReadResponse.ResultEntry
+	2,  // 11: ose.micro.postman.webhook.v1.Deliveries.data:type_name -> ose.micro.postman.webhook.v1.Delivery
+	18, // 12: ose.micro.postman.webhook.v1.DeliveriesRequest.request:type_name -> ose.micro.common.v1.Request
+	16, // 13: ose.micro.postman.webhook.v1.DeliveriesResponse.result:type_name -> ose.micro.postman.webhook.v1.DeliveriesResponse.ResultEntry
+	7,  // 14: ose.micro.postman.webhook.v1.ReadResponse.ResultEntry.value:type_name -> ose.micro.postman.webhook.v1.Subscriptions
+	10, // 15: ose.micro.postman.webhook.v1.DeliveriesResponse.ResultEntry.value:type_name -> ose.micro.postman.webhook.v1.Deliveries
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_ose_micro_postman_webhook_v1_data_proto_init() }
+func file_ose_micro_postman_webhook_v1_data_proto_init() {
+	if File_ose_micro_postman_webhook_v1_data_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ose_micro_postman_webhook_v1_data_proto_rawDesc), len(file_ose_micro_postman_webhook_v1_data_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ose_micro_postman_webhook_v1_data_proto_goTypes,
+		DependencyIndexes: file_ose_micro_postman_webhook_v1_data_proto_depIdxs,
+		EnumInfos:         file_ose_micro_postman_webhook_v1_data_proto_enumTypes,
+		MessageInfos:      file_ose_micro_postman_webhook_v1_data_proto_msgTypes,
+	}.Build()
+	File_ose_micro_postman_webhook_v1_data_proto = out.File
+	file_ose_micro_postman_webhook_v1_data_proto_goTypes = nil
+	file_ose_micro_postman_webhook_v1_data_proto_depIdxs = nil
+}
@@ -0,0 +1,89 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: ose/micro/postman/webhook/v1/service.proto
+
+package webhookv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_ose_micro_postman_webhook_v1_service_proto protoreflect.FileDescriptor
+
+const file_ose_micro_postman_webhook_v1_service_proto_rawDesc = "" +
+	"\n" +
+	"*ose/micro/postman/webhook/v1/service.proto\x12\x1cose.micro.postman.webhook.v1\x1a'ose/micro/postman/webhook/v1/data.proto2\x8f\x04\n" +
+	"\x0eWebhookService\x12c\n" +
+	"\x06Create\x12+.ose.micro.postman.webhook.v1.CreateRequest\x1a,.ose.micro.postman.webhook.v1.CreateResponse\x12c\n" +
+	"\x06Delete\x12+.ose.micro.postman.webhook.v1.DeleteRequest\x1a,.ose.micro.postman.webhook.v1.DeleteResponse\x12]\n" +
+	"\x04Read\x12).ose.micro.postman.webhook.v1.ReadRequest\x1a*.ose.micro.postman.webhook.v1.ReadResponse\x12o\n" +
+	"\n" +
+	"Deliveries\x12/.ose.micro.postman.webhook.v1.DeliveriesRequest\x1a0.ose.micro.postman.webhook.v1.DeliveriesResponse\x12c\n" +
+	"\x06Replay\x12+.ose.micro.postman.webhook.v1.ReplayRequest\x1a,.ose.micro.postman.webhook.v1.ReplayResponseB\xa3\x02\n" +
+	" com.ose.micro.postman.webhook.v1B\fServiceProtoP\x01Z\\github.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/webhook/v1;webhookv1\xa2\x02\x04OMPW\xaa\x02\x1cOse.Micro.Postman.Webhook.V1\xca\x02\x1cOse\\Micro\\Postman\\Webhook\\V1\xe2\x02(Ose\\Micro\\Postman\\Webhook\\V1\\GPBMetadata\xea\x02 Ose::Micro::Postman::Webhook::V1b\x06proto3"
+
+var file_ose_micro_postman_webhook_v1_service_proto_goTypes = []any{
+	(*CreateRequest)(nil),      // 0: ose.micro.postman.webhook.v1.CreateRequest
+	(*DeleteRequest)(nil),      // 1: ose.micro.postman.webhook.v1.DeleteRequest
+	(*ReadRequest)(nil),        // 2: ose.micro.postman.webhook.v1.ReadRequest
+	(*DeliveriesRequest)(nil),  // 3: ose.micro.postman.webhook.v1.DeliveriesRequest
+	(*ReplayRequest)(nil),      // 4: ose.micro.postman.webhook.v1.ReplayRequest
+	(*CreateResponse)(nil),     // 5: ose.micro.postman.webhook.v1.CreateResponse
+	(*DeleteResponse)(nil),     // 6: ose.micro.postman.webhook.v1.DeleteResponse
+	(*ReadResponse)(nil),       // 7: ose.micro.postman.webhook.v1.ReadResponse
+	(*DeliveriesResponse)(nil), // 8: ose.micro.postman.webhook.v1.DeliveriesResponse
+	(*ReplayResponse)(nil),     // 9: ose.micro.postman.webhook.v1.ReplayResponse
+}
+var file_ose_micro_postman_webhook_v1_service_proto_depIdxs = []int32{
+	0, // 0: ose.micro.postman.webhook.v1.WebhookService.Create:input_type -> ose.micro.postman.webhook.v1.CreateRequest
+	1, // 1: ose.micro.postman.webhook.v1.WebhookService.Delete:input_type -> ose.micro.postman.webhook.v1.DeleteRequest
+	2, // 2: ose.micro.postman.webhook.v1.WebhookService.Read:input_type -> ose.micro.postman.webhook.v1.ReadRequest
+	3, // 3: ose.micro.postman.webhook.v1.WebhookService.Deliveries:input_type -> ose.micro.postman.webhook.v1.DeliveriesRequest
+	4, // 4: ose.micro.postman.webhook.v1.WebhookService.Replay:input_type -> ose.micro.postman.webhook.v1.ReplayRequest
+	5, // 5: ose.micro.postman.webhook.v1.WebhookService.Create:output_type -> ose.micro.postman.webhook.v1.CreateResponse
+	6, // 6: ose.micro.postman.webhook.v1.WebhookService.Delete:output_type -> ose.micro.postman.webhook.v1.DeleteResponse
+	7, // 7: ose.micro.postman.webhook.v1.WebhookService.Read:output_type -> ose.micro.postman.webhook.v1.ReadResponse
+	8, // 8: ose.micro.postman.webhook.v1.WebhookService.Deliveries:output_type -> ose.micro.postman.webhook.v1.DeliveriesResponse
+	9, // 9: ose.micro.postman.webhook.v1.WebhookService.Replay:output_type -> ose.micro.postman.webhook.v1.ReplayResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_ose_micro_postman_webhook_v1_service_proto_init() }
+func file_ose_micro_postman_webhook_v1_service_proto_init() {
+	if File_ose_micro_postman_webhook_v1_service_proto != nil {
+		return
+	}
+	file_ose_micro_postman_webhook_v1_data_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ose_micro_postman_webhook_v1_service_proto_rawDesc), len(file_ose_micro_postman_webhook_v1_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ose_micro_postman_webhook_v1_service_proto_goTypes,
+		DependencyIndexes: file_ose_micro_postman_webhook_v1_service_proto_depIdxs,
+	}.Build()
+	File_ose_micro_postman_webhook_v1_service_proto = out.File
+	file_ose_micro_postman_webhook_v1_service_proto_goTypes = nil
+	file_ose_micro_postman_webhook_v1_service_proto_depIdxs = nil
+}
@@ -0,0 +1,243 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	ose_error "github.com/ose-micro/error"
+	adminv1 "github.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/admin/v1"
+	"github.com/ose-micro/postman/internal/app"
+	"github.com/ose-micro/postman/internal/business/deadletter"
+	"github.com/ose-micro/postman/internal/infrastructure/ratelimit"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	AdminHandler struct {
+		adminv1.UnimplementedAdminServiceServer
+		limiter     *ratelimit.Limiter
+		deadLetters deadletter.App
+		log         logger.Logger
+		tracer      tracing.Tracer
+	}
+)
+
+var deadLetterKinds = map[deadletter.Kind]adminv1.DeadLetterKind{
+	deadletter.KindPoison:    adminv1.DeadLetterKind_DeadLetterKindPoison,
+	deadletter.KindExhausted: adminv1.DeadLetterKind_DeadLetterKindExhausted,
+}
+
+var deadLetterStates = map[deadletter.State]adminv1.DeadLetterState{
+	deadletter.StateDead:     adminv1.DeadLetterState_DeadLetterStateDead,
+	deadletter.StateReplayed: adminv1.DeadLetterState_DeadLetterStateReplayed,
+}
+
+func (a *AdminHandler) deadLetter(param deadletter.Letter) *adminv1.DeadLetter {
+	return &adminv1.DeadLetter{
+		Id:         param.Id,
+		Subject:    param.Subject,
+		Payload:    param.Payload,
+		Reason:     param.Reason,
+		Kind:       deadLetterKinds[param.Kind],
+		Attempts:   param.Attempts,
+		State:      deadLetterStates[param.State],
+		CreatedAt:  timestamppb.New(param.CreatedAt),
+		ReplayedAt: optionalTimestamp(param.ReplayedAt),
+	}
+}
+
+func (a *AdminHandler) RateLimits(ctx context.Context, request *adminv1.RateLimitsRequest) (*adminv1.RateLimitsResponse, error) {
+	ctx, span := a.tracer.Start(ctx, "api.grpc.admin.rate_limits.handler", trace.WithAttributes(
+		attribute.String("operation", "rate_limits"),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	states := a.limiter.Snapshot()
+	data := make([]*adminv1.RateLimit, 0, len(states))
+	for _, state := range states {
+		data = append(data, &adminv1.RateLimit{
+			Scope:     string(state.Key.Scope),
+			Name:      state.Key.Name,
+			Rate:      state.Rate,
+			Burst:     int32(state.Burst),
+			Tokens:    state.Tokens,
+			Throttled: state.Throttled,
+			UpdatedAt: timestamppb.New(state.UpdatedAt),
+		})
+	}
+
+	a.log.Info("rate limits read successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "rate_limits"),
+		zap.Int("count", len(data)),
+	)
+
+	return &adminv1.RateLimitsResponse{
+		Data: data,
+	}, nil
+}
+
+func (a *AdminHandler) DeadLetters(ctx context.Context, request *adminv1.DeadLettersRequest) (*adminv1.DeadLettersResponse, error) {
+	ctx, span := a.tracer.Start(ctx, "api.grpc.admin.dead_letters.handler", trace.WithAttributes(
+		attribute.String("operation", "dead_letters"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	query, err := buildAppRequest(request.Request)
+	if err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		a.log.Error("failed to case to dto",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "dead_letters"),
+			zap.Error(err),
+		)
+		return nil, parseError(err)
+	}
+
+	records, err := a.deadLetters.Read(ctx, *query)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		a.log.Error("failed to read dead letters",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "dead_letters"),
+			zap.Error(err),
+		)
+		return nil, parseError(err)
+	}
+
+	result := map[string]*adminv1.DeadLetters{}
+
+	for k, v := range records {
+		switch x := v.(type) {
+		case []deadletter.Letter:
+			list := make([]*adminv1.DeadLetter, 0)
+			for _, v := range x {
+				list = append(list, a.deadLetter(v))
+			}
+			result[k] = &adminv1.DeadLetters{
+				Data: list,
+			}
+		}
+	}
+
+	return &adminv1.DeadLettersResponse{
+		Result: result,
+	}, nil
+}
+
+func (a *AdminHandler) DeadLetter(ctx context.Context, request *adminv1.DeadLetterRequest) (*adminv1.DeadLetterResponse, error) {
+	ctx, span := a.tracer.Start(ctx, "api.grpc.admin.dead_letter.handler", trace.WithAttributes(
+		attribute.String("operation", "dead_letter"),
+		attribute.String("id", request.Id),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := a.deadLetters.Inspect(ctx, deadletter.InspectQuery{Id: request.Id})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		a.log.Error("failed to inspect dead letter",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "dead_letter"),
+			zap.Error(err),
+		)
+
+		return nil, parseError(err)
+	}
+
+	return &adminv1.DeadLetterResponse{
+		Record: a.deadLetter(*record),
+	}, nil
+}
+
+func (a *AdminHandler) ReplayDeadLetter(ctx context.Context, request *adminv1.ReplayDeadLetterRequest) (*adminv1.ReplayDeadLetterResponse, error) {
+	ctx, span := a.tracer.Start(ctx, "api.grpc.admin.replay_dead_letter.handler", trace.WithAttributes(
+		attribute.String("operation", "replay_dead_letter"),
+		attribute.String("id", request.Id),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := a.deadLetters.Replay(ctx, deadletter.ReplayCommand{Id: request.Id})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		a.log.Error("failed to replay dead letter",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "replay_dead_letter"),
+			zap.Error(err),
+		)
+
+		return nil, parseError(err)
+	}
+
+	a.log.Info("replay dead letter process successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "replay_dead_letter"),
+		zap.String("id", record.Id),
+	)
+
+	return &adminv1.ReplayDeadLetterResponse{
+		Message: "dead letter replayed successfully",
+		Record:  a.deadLetter(*record),
+	}, nil
+}
+
+func (a *AdminHandler) PurgeDeadLetters(ctx context.Context, request *adminv1.PurgeDeadLettersRequest) (*adminv1.PurgeDeadLettersResponse, error) {
+	ctx, span := a.tracer.Start(ctx, "api.grpc.admin.purge_dead_letters.handler", trace.WithAttributes(
+		attribute.String("operation", "purge_dead_letters"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	purged, err := a.deadLetters.Purge(ctx, deadletter.PurgeCommand{
+		Id:  request.Id,
+		All: request.All,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		a.log.Error("failed to purge dead letters",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "purge_dead_letters"),
+			zap.Error(err),
+		)
+
+		return nil, parseError(err)
+	}
+
+	a.log.Info("purge dead letters process successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "purge_dead_letters"),
+		zap.Int64("purged", purged),
+	)
+
+	return &adminv1.PurgeDeadLettersResponse{
+		Message: "dead letters purged successfully",
+		Purged:  purged,
+	}, nil
+}
+
+func NewAdmin(limiter *ratelimit.Limiter, apps app.Apps, log logger.Logger, tracer tracing.Tracer) *AdminHandler {
+	return &AdminHandler{
+		limiter:     limiter,
+		deadLetters: apps.DeadLetter,
+		log:         log,
+		tracer:      tracer,
+	}
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ose-micro/core/logger"
 	"github.com/ose-micro/core/tracing"
@@ -28,45 +29,148 @@ type (
 
 func (e *EmailHandler) response(param email.Public) *emailv1.Email {
 	return &emailv1.Email{
-		Id:        param.Id,
-		Count:     param.Count,
-		Recipient: param.Recipient,
-		Data:      stringifyInterfaceMap(param.Data),
-		Subject:   param.Subject,
-		Sender:    param.Sender,
-		From:      param.From,
-		Template:  param.Template,
-		Message:   param.Message,
-		State: func() emailv1.State {
-			switch param.State {
-			case email.StateComplete:
-				return emailv1.State_StateComplete
-			case email.StateFailed:
-				return emailv1.State_StateFailed
-			default:
-				return emailv1.State_StateUnknown
-			}
-		}(),
-		CreatedAt: timestamppb.New(param.CreatedAt),
-		UpdatedAt: timestamppb.New(param.UpdatedAt),
+		Id:              param.Id,
+		Count:           param.Count,
+		Recipient:       param.Recipient,
+		To:              param.To,
+		Cc:              param.Cc,
+		Bcc:             param.Bcc,
+		ReplyTo:         param.ReplyTo,
+		Data:            stringifyInterfaceMap(param.Data),
+		Subject:         param.Subject,
+		Sender:          param.Sender,
+		From:            param.From,
+		Template:        param.Template,
+		TemplateVersion: param.TemplateVersion,
+		Message:         param.Message,
+		Text:            param.Text,
+		State:           state(param.State),
+		LastError:       param.LastError,
+		Attempts:        attempts(param.Attempts),
+		RetryAt:         optionalTimestamp(param.RetryAt),
+		SendAt:          optionalTimestamp(param.SendAt),
+		IdempotencyKey:  param.IdempotencyKey,
+		Attachments:     attachments(param.Attachments),
+		Headers:         param.Headers,
+		Tags:            param.Tags,
+		MessageId:       param.MessageId,
+		Provider:        param.Provider,
+		Category:        param.Category,
+		Suppressed:      param.Suppressed,
+		CreatedAt:       timestamppb.New(param.CreatedAt),
+		UpdatedAt:       timestamppb.New(param.UpdatedAt),
 	}
 }
 
+var states = map[email.State]emailv1.State{
+	email.StateQueued:     emailv1.State_StateQueued,
+	email.StateScheduled:  emailv1.State_StateScheduled,
+	email.StateSending:    emailv1.State_StateSending,
+	email.StateSent:       emailv1.State_StateSent,
+	email.StateDelivered:  emailv1.State_StateDelivered,
+	email.StateBounced:    emailv1.State_StateBounced,
+	email.StateComplained: emailv1.State_StateComplained,
+	email.StateCancelled:  emailv1.State_StateCancelled,
+	email.StateSuppressed: emailv1.State_StateSuppressed,
+	email.StateFailed:     emailv1.State_StateFailed,
+}
+
+func state(param email.State) emailv1.State {
+	if value, ok := states[param.Canonical()]; ok {
+		return value
+	}
+
+	return emailv1.State_StateUnknown
+}
+
+func optionalTimestamp(at *time.Time) *timestamppb.Timestamp {
+	if at == nil {
+		return nil
+	}
+
+	return timestamppb.New(*at)
+}
+
+func attachments(params []email.Attachment) []*emailv1.Attachment {
+	list := make([]*emailv1.Attachment, 0, len(params))
+	for _, a := range params {
+		list = append(list, &emailv1.Attachment{
+			Filename:    a.Filename,
+			ContentType: a.ContentType,
+			Blob:        a.Blob,
+			ContentId:   a.ContentId,
+			Inline:      a.Inline,
+			Size:        a.Size,
+		})
+	}
+
+	return list
+}
+
+func newAttachments(request []*emailv1.Attachment) []email.NewAttachment {
+	list := make([]email.NewAttachment, 0, len(request))
+	for _, a := range request {
+		list = append(list, email.NewAttachment{
+			Filename:    a.Filename,
+			ContentType: a.ContentType,
+			Content:     a.Content,
+			Blob:        a.Blob,
+			ContentId:   a.ContentId,
+			Inline:      a.Inline,
+		})
+	}
+
+	return list
+}
+
+func attempts(params []email.Attempt) []*emailv1.Attempt {
+	list := make([]*emailv1.Attempt, 0, len(params))
+	for _, a := range params {
+		list = append(list, &emailv1.Attempt{
+			At:         timestamppb.New(a.At),
+			Provider:   a.Provider,
+			Code:       a.Code,
+			Response:   a.Response,
+			Error:      a.Error,
+			DurationMs: a.DurationMs,
+			MessageId:  a.MessageId,
+			ProviderId: a.ProviderId,
+		})
+	}
+
+	return list
+}
+
 func (e *EmailHandler) Create(ctx context.Context, request *emailv1.CreateRequest) (*emailv1.CreateResponse, error) {
+	// the request carries attachment bytes, so only the command is traced and logged
 	ctx, span := e.tracer.Start(ctx, "api.grpc.email.create.handler", trace.WithAttributes(
 		attribute.String("operation", "CREATE"),
-		attribute.String("payload", fmt.Sprintf("%v", request)),
 	))
 	defer span.End()
 
 	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
 	payload := email.CreateCommand{
-		Recipient: request.Recipient,
-		Sender:    request.Sender,
-		Data:      convertStringMapToInterfaceMap(request.Data),
-		Template:  request.Template,
-		From:      request.From,
+		Recipient:      request.Recipient,
+		To:             request.To,
+		Cc:             request.Cc,
+		Bcc:            request.Bcc,
+		ReplyTo:        request.ReplyTo,
+		Sender:         request.Sender,
+		Data:           convertStringMapToInterfaceMap(request.Data),
+		Template:       request.Template,
+		From:           request.From,
+		IdempotencyKey: request.IdempotencyKey,
+		Attachments:    newAttachments(request.Attachments),
+		Headers:        request.Headers,
+		Tags:           request.Tags,
+		Category:       request.Category,
+	}
+
+	if request.SendAt != nil {
+		sendAt := request.SendAt.AsTime()
+		payload.SendAt = &sendAt
 	}
+	span.SetAttributes(attribute.String("payload", payload.String()))
 
 	record, err := e.app.Create(ctx, payload)
 	if err != nil {
@@ -84,7 +188,7 @@ func (e *EmailHandler) Create(ctx context.Context, request *emailv1.CreateReques
 	e.log.Info("email create process successfully",
 		zap.String("trace_id", traceId),
 		zap.String("operation", "CREATE"),
-		zap.Any("payload", request),
+		zap.Any("payload", payload),
 	)
 
 	return &emailv1.CreateResponse{
@@ -126,6 +230,39 @@ func (e *EmailHandler) Resend(ctx context.Context, request *emailv1.ResendReques
 	}, nil
 }
 
+func (e *EmailHandler) Cancel(ctx context.Context, request *emailv1.CancelRequest) (*emailv1.CancelResponse, error) {
+	ctx, span := e.tracer.Start(ctx, "api.grpc.email.cancel.handler", trace.WithAttributes(
+		attribute.String("operation", "cancel"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := e.app.Cancel(ctx, email.IdCommand{Id: request.Id})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		e.log.Error("failed to cancel email",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "cancel"),
+			zap.Error(err),
+		)
+
+		return nil, parseError(err)
+	}
+
+	e.log.Info("cancel email process successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "cancel"),
+		zap.Any("payload", request),
+	)
+
+	return &emailv1.CancelResponse{
+		Message: "email cancelled successfully",
+		Record:  e.response(record.Public()),
+	}, nil
+}
+
 func (e *EmailHandler) Read(ctx context.Context, request *emailv1.ReadRequest) (*emailv1.ReadResponse, error) {
 	ctx, span := e.tracer.Start(ctx, "api.grpc.email.repository.handler", trace.WithAttributes(
 		attribute.String("operation", "read"),
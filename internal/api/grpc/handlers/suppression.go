@@ -0,0 +1,234 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	ose_error "github.com/ose-micro/error"
+	suppressionv1 "github.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/suppression/v1"
+	"github.com/ose-micro/postman/internal/app"
+	"github.com/ose-micro/postman/internal/business/suppression"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	SuppressionHandler struct {
+		suppressionv1.UnimplementedSuppressionServiceServer
+		app    suppression.App
+		log    logger.Logger
+		tracer tracing.Tracer
+	}
+)
+
+func (s *SuppressionHandler) response(param suppression.Public) *suppressionv1.Suppression {
+	return &suppressionv1.Suppression{
+		Id:        param.Id,
+		Address:   param.Address,
+		Reason:    reasons[param.Reason],
+		Source:    param.Source,
+		Category:  param.Category,
+		Note:      param.Note,
+		ExpiresAt: optionalTimestamp(param.ExpiresAt),
+		CreatedAt: timestamppb.New(param.CreatedAt),
+		UpdatedAt: timestamppb.New(param.UpdatedAt),
+	}
+}
+
+var reasons = map[suppression.Reason]suppressionv1.Reason{
+	suppression.ReasonBounce:      suppressionv1.Reason_ReasonBounce,
+	suppression.ReasonComplaint:   suppressionv1.Reason_ReasonComplaint,
+	suppression.ReasonUnsubscribe: suppressionv1.Reason_ReasonUnsubscribe,
+	suppression.ReasonManual:      suppressionv1.Reason_ReasonManual,
+}
+
+func reason(param suppressionv1.Reason) suppression.Reason {
+	for key, value := range reasons {
+		if value == param {
+			return key
+		}
+	}
+
+	return ""
+}
+
+func (s *SuppressionHandler) Add(ctx context.Context, request *suppressionv1.AddRequest) (*suppressionv1.AddResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "api.grpc.suppression.add.handler", trace.WithAttributes(
+		attribute.String("operation", "add"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	payload := suppression.AddCommand{
+		Address:  request.Address,
+		Reason:   reason(request.Reason),
+		Source:   request.Source,
+		Category: request.Category,
+		Note:     request.Note,
+	}
+
+	if request.ExpiresAt != nil {
+		expiresAt := request.ExpiresAt.AsTime()
+		payload.ExpiresAt = &expiresAt
+	}
+
+	record, err := s.app.Add(ctx, payload)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to add suppression",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "add"),
+			zap.Error(err),
+		)
+
+		return nil, parseError(err)
+	}
+
+	s.log.Info("add suppression process successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "add"),
+		zap.Any("payload", request),
+	)
+
+	return &suppressionv1.AddResponse{
+		Message: "address suppressed successfully",
+		Record:  s.response(record.Public()),
+	}, nil
+}
+
+func (s *SuppressionHandler) Remove(ctx context.Context, request *suppressionv1.RemoveRequest) (*suppressionv1.RemoveResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "api.grpc.suppression.remove.handler", trace.WithAttributes(
+		attribute.String("operation", "remove"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	if err := s.app.Remove(ctx, suppression.RemoveCommand{
+		Id:       request.Id,
+		Address:  request.Address,
+		Category: request.Category,
+	}); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to remove suppression",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "remove"),
+			zap.Error(err),
+		)
+
+		return nil, parseError(err)
+	}
+
+	s.log.Info("remove suppression process successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "remove"),
+		zap.Any("payload", request),
+	)
+
+	return &suppressionv1.RemoveResponse{
+		Message: "suppression removed successfully",
+	}, nil
+}
+
+func (s *SuppressionHandler) Read(ctx context.Context, request *suppressionv1.ReadRequest) (*suppressionv1.ReadResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "api.grpc.suppression.read.handler", trace.WithAttributes(
+		attribute.String("operation", "read"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	query, err := buildAppRequest(request.Request)
+	if err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to case to dto",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read"),
+			zap.Error(err),
+		)
+		return nil, parseError(err)
+	}
+
+	records, err := s.app.Read(ctx, *query)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to read suppressions",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read"),
+			zap.Error(err),
+		)
+		return nil, parseError(err)
+	}
+
+	result := map[string]*suppressionv1.Suppressions{}
+
+	for k, v := range records {
+		switch x := v.(type) {
+		case []suppression.Public:
+			list := make([]*suppressionv1.Suppression, 0)
+			for _, v := range x {
+				list = append(list, s.response(v))
+			}
+			result[k] = &suppressionv1.Suppressions{
+				Data: list,
+			}
+		}
+	}
+
+	return &suppressionv1.ReadResponse{
+		Result: result,
+	}, nil
+}
+
+func (s *SuppressionHandler) Check(ctx context.Context, request *suppressionv1.CheckRequest) (*suppressionv1.CheckResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "api.grpc.suppression.check.handler", trace.WithAttributes(
+		attribute.String("operation", "check"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	records, err := s.app.Check(ctx, suppression.CheckQuery{
+		Addresses: request.Addresses,
+		Category:  request.Category,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to check suppressions",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "check"),
+			zap.Error(err),
+		)
+
+		return nil, parseError(err)
+	}
+
+	data := make([]*suppressionv1.Suppression, 0, len(records))
+	for _, record := range records {
+		data = append(data, s.response(record.Public()))
+	}
+
+	return &suppressionv1.CheckResponse{
+		Data: data,
+	}, nil
+}
+
+func NewSuppression(apps app.Apps, log logger.Logger, tracer tracing.Tracer) *SuppressionHandler {
+	return &SuppressionHandler{
+		app:    apps.Suppression,
+		log:    log,
+		tracer: tracer,
+	}
+}
@@ -29,15 +29,42 @@ type (
 func (e *TemplateHandler) response(param template.Public) *templatev1.Template {
 	return &templatev1.Template{
 		Id:           param.Id,
+		Key:          param.Key,
 		Count:        param.Count,
 		Subject:      param.Subject,
-		Content:      param.Content,
+		HtmlContent:  param.Html(),
+		Content:      param.Html(),
+		TextContent:  param.TextContent,
 		Placeholders: param.Placeholders,
+		Version:      param.Version,
 		CreatedAt:    timestamppb.New(param.CreatedAt),
 		UpdatedAt:    timestamppb.New(param.UpdatedAt),
 	}
 }
 
+func (e *TemplateHandler) revision(param template.Revision) *templatev1.Revision {
+	return &templatev1.Revision{
+		Id:           param.Id,
+		Template:     param.Template,
+		Version:      param.Version,
+		Subject:      param.Subject,
+		HtmlContent:  param.HtmlContent,
+		Content:      param.HtmlContent,
+		TextContent:  param.TextContent,
+		Placeholders: param.Placeholders,
+		CreatedAt:    timestamppb.New(param.CreatedAt),
+	}
+}
+
+// htmlContent prefers html_content and falls back to the deprecated content field.
+func htmlContent(html, content string) string {
+	if html == "" {
+		return content
+	}
+
+	return html
+}
+
 func (e *TemplateHandler) Create(ctx context.Context, request *templatev1.CreateRequest) (*templatev1.CreateResponse, error) {
 	ctx, span := e.tracer.Start(ctx, "api.grpc.template.create.handler", trace.WithAttributes(
 		attribute.String("operation", "create"),
@@ -47,7 +74,9 @@ func (e *TemplateHandler) Create(ctx context.Context, request *templatev1.Create
 
 	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
 	payload := template.CreateCommand{
-		Content:      request.Content,
+		Key:          request.Key,
+		HtmlContent:  htmlContent(request.HtmlContent, request.Content),
+		TextContent:  request.TextContent,
 		Subject:      request.Subject,
 		Placeholders: request.Placeholders,
 	}
@@ -140,7 +169,9 @@ func (r *TemplateHandler) Update(ctx context.Context, request *templatev1.Update
 	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
 	payload := template.UpdateCommand{
 		Id:           request.Id,
-		Content:      request.Content,
+		Key:          request.Key,
+		HtmlContent:  htmlContent(request.HtmlContent, request.Content),
+		TextContent:  request.TextContent,
 		Subject:      request.Subject,
 		Placeholders: request.Placeholders,
 	}
@@ -191,6 +222,132 @@ func (r *TemplateHandler) Delete(ctx context.Context, request *templatev1.Delete
 	}, nil
 }
 
+func (r *TemplateHandler) ListRevisions(ctx context.Context, request *templatev1.ListRevisionsRequest) (*templatev1.ListRevisionsResponse, error) {
+	ctx, span := r.tracer.Start(ctx, "api.grpc.template.list_revisions.handler", trace.WithAttributes(
+		attribute.String("operation", "list_revisions"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	records, err := r.app.Revisions(ctx, template.RevisionsQuery{
+		Id:    request.Id,
+		Skip:  request.Skip,
+		Limit: request.Limit,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to list template revisions",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "list_revisions"),
+			zap.Error(err),
+		)
+		return nil, parseError(err)
+	}
+
+	list := make([]*templatev1.Revision, 0, len(records))
+	for _, v := range records {
+		list = append(list, r.revision(v))
+	}
+
+	return &templatev1.ListRevisionsResponse{
+		Data: list,
+	}, nil
+}
+
+func (r *TemplateHandler) GetRevision(ctx context.Context, request *templatev1.GetRevisionRequest) (*templatev1.GetRevisionResponse, error) {
+	ctx, span := r.tracer.Start(ctx, "api.grpc.template.get_revision.handler", trace.WithAttributes(
+		attribute.String("operation", "get_revision"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := r.app.Revision(ctx, template.RevisionQuery{
+		Id:      request.Id,
+		Version: request.Version,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to get template revision",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "get_revision"),
+			zap.Error(err),
+		)
+		return nil, parseError(err)
+	}
+
+	return &templatev1.GetRevisionResponse{
+		Record: r.revision(*record),
+	}, nil
+}
+
+func (r *TemplateHandler) Rollback(ctx context.Context, request *templatev1.RollbackRequest) (*templatev1.RollbackResponse, error) {
+	ctx, span := r.tracer.Start(ctx, "api.grpc.template.rollback.handler", trace.WithAttributes(
+		attribute.String("operation", "rollback"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := r.app.Rollback(ctx, template.RollbackCommand{
+		Id:      request.Id,
+		Version: request.Version,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to rollback template",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "rollback"),
+			zap.Error(err),
+		)
+		return nil, parseError(err)
+	}
+
+	return &templatev1.RollbackResponse{
+		Message: "template rollback successfully",
+		Record:  r.response(record.Public()),
+	}, nil
+}
+
+func (r *TemplateHandler) Render(ctx context.Context, request *templatev1.RenderRequest) (*templatev1.RenderResponse, error) {
+	ctx, span := r.tracer.Start(ctx, "api.grpc.template.render.handler", trace.WithAttributes(
+		attribute.String("operation", "render"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := r.app.Render(ctx, template.RenderQuery{
+		Template:    request.Template,
+		HtmlContent: htmlContent(request.HtmlContent, request.Content),
+		TextContent: request.TextContent,
+		Subject:     request.Subject,
+		Data:        convertStringMapToInterfaceMap(request.Data),
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to render template",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "render"),
+			zap.Error(err),
+		)
+		return nil, parseError(err)
+	}
+
+	return &templatev1.RenderResponse{
+		Subject: record.Subject,
+		Html:    record.Html,
+		Text:    record.Text,
+		Missing: record.Missing,
+		Unused:  record.Unused,
+	}, nil
+}
+
 func NewTemplate(apps app.Apps, log logger.Logger, tracer tracing.Tracer) *TemplateHandler {
 	return &TemplateHandler{
 		app:    apps.Template,
@@ -0,0 +1,283 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	ose_error "github.com/ose-micro/error"
+	webhookv1 "github.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/webhook/v1"
+	"github.com/ose-micro/postman/internal/app"
+	"github.com/ose-micro/postman/internal/business/subscription"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	WebhookHandler struct {
+		webhookv1.UnimplementedWebhookServiceServer
+		app    subscription.App
+		log    logger.Logger
+		tracer tracing.Tracer
+	}
+)
+
+// response leaves the secret out; it is only returned by Create.
+func (w *WebhookHandler) response(param subscription.Public) *webhookv1.Subscription {
+	return &webhookv1.Subscription{
+		Id:        param.Id,
+		Url:       param.Url,
+		Filter:    param.Filter,
+		Tenant:    param.Tenant,
+		CreatedAt: timestamppb.New(param.CreatedAt),
+		UpdatedAt: timestamppb.New(param.UpdatedAt),
+	}
+}
+
+var deliveryStates = map[subscription.DeliveryState]webhookv1.DeliveryState{
+	subscription.DeliveryPending:   webhookv1.DeliveryState_DeliveryStatePending,
+	subscription.DeliveryDelivered: webhookv1.DeliveryState_DeliveryStateDelivered,
+	subscription.DeliveryFailed:    webhookv1.DeliveryState_DeliveryStateFailed,
+}
+
+func (w *WebhookHandler) delivery(param subscription.Delivery) *webhookv1.Delivery {
+	return &webhookv1.Delivery{
+		Id:             param.Id,
+		SubscriptionId: param.SubscriptionId,
+		EmailId:        param.EmailId,
+		Event:          param.Event,
+		Payload:        param.Payload,
+		State:          deliveryStates[param.State],
+		Attempts:       param.Attempts,
+		Status:         param.Status,
+		LastError:      param.LastError,
+		NextAttemptAt:  optionalTimestamp(param.NextAttemptAt),
+		DeliveredAt:    optionalTimestamp(param.DeliveredAt),
+		CreatedAt:      timestamppb.New(param.CreatedAt),
+		UpdatedAt:      timestamppb.New(param.UpdatedAt),
+	}
+}
+
+func (w *WebhookHandler) Create(ctx context.Context, request *webhookv1.CreateRequest) (*webhookv1.CreateResponse, error) {
+	ctx, span := w.tracer.Start(ctx, "api.grpc.webhook.create.handler", trace.WithAttributes(
+		attribute.String("operation", "create"),
+		attribute.String("url", request.Url),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := w.app.Create(ctx, subscription.CreateCommand{
+		Url:    request.Url,
+		Secret: request.Secret,
+		Filter: request.Filter,
+		Tenant: request.Tenant,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		w.log.Error("failed to create subscription",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "create"),
+			zap.Error(err),
+		)
+
+		return nil, parseError(err)
+	}
+
+	w.log.Info("create subscription process successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "create"),
+		zap.String("id", record.ID()),
+	)
+
+	return &webhookv1.CreateResponse{
+		Message: "subscription created successfully",
+		Record:  w.response(record.Public()),
+		Secret:  record.Secret(),
+	}, nil
+}
+
+func (w *WebhookHandler) Delete(ctx context.Context, request *webhookv1.DeleteRequest) (*webhookv1.DeleteResponse, error) {
+	ctx, span := w.tracer.Start(ctx, "api.grpc.webhook.delete.handler", trace.WithAttributes(
+		attribute.String("operation", "delete"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	if err := w.app.Delete(ctx, subscription.IdCommand{Id: request.Id}); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		w.log.Error("failed to delete subscription",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "delete"),
+			zap.Error(err),
+		)
+
+		return nil, parseError(err)
+	}
+
+	w.log.Info("delete subscription process successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "delete"),
+		zap.Any("payload", request),
+	)
+
+	return &webhookv1.DeleteResponse{
+		Message: "subscription deleted successfully",
+	}, nil
+}
+
+func (w *WebhookHandler) Read(ctx context.Context, request *webhookv1.ReadRequest) (*webhookv1.ReadResponse, error) {
+	ctx, span := w.tracer.Start(ctx, "api.grpc.webhook.read.handler", trace.WithAttributes(
+		attribute.String("operation", "read"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	query, err := buildAppRequest(request.Request)
+	if err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		w.log.Error("failed to case to dto",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read"),
+			zap.Error(err),
+		)
+		return nil, parseError(err)
+	}
+
+	records, err := w.app.Read(ctx, *query)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		w.log.Error("failed to read subscriptions",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read"),
+			zap.Error(err),
+		)
+		return nil, parseError(err)
+	}
+
+	result := map[string]*webhookv1.Subscriptions{}
+
+	for k, v := range records {
+		switch x := v.(type) {
+		case []subscription.Public:
+			list := make([]*webhookv1.Subscription, 0)
+			for _, v := range x {
+				list = append(list, w.response(v))
+			}
+			result[k] = &webhookv1.Subscriptions{
+				Data: list,
+			}
+		}
+	}
+
+	return &webhookv1.ReadResponse{
+		Result: result,
+	}, nil
+}
+
+func (w *WebhookHandler) Deliveries(ctx context.Context, request *webhookv1.DeliveriesRequest) (*webhookv1.DeliveriesResponse, error) {
+	ctx, span := w.tracer.Start(ctx, "api.grpc.webhook.deliveries.handler", trace.WithAttributes(
+		attribute.String("operation", "deliveries"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	query, err := buildAppRequest(request.Request)
+	if err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		w.log.Error("failed to case to dto",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "deliveries"),
+			zap.Error(err),
+		)
+		return nil, parseError(err)
+	}
+
+	records, err := w.app.Deliveries(ctx, *query)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		w.log.Error("failed to read deliveries",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "deliveries"),
+			zap.Error(err),
+		)
+		return nil, parseError(err)
+	}
+
+	result := map[string]*webhookv1.Deliveries{}
+
+	for k, v := range records {
+		switch x := v.(type) {
+		case []subscription.Delivery:
+			list := make([]*webhookv1.Delivery, 0)
+			for _, v := range x {
+				list = append(list, w.delivery(v))
+			}
+			result[k] = &webhookv1.Deliveries{
+				Data: list,
+			}
+		}
+	}
+
+	return &webhookv1.DeliveriesResponse{
+		Result: result,
+	}, nil
+}
+
+func (w *WebhookHandler) Replay(ctx context.Context, request *webhookv1.ReplayRequest) (*webhookv1.ReplayResponse, error) {
+	ctx, span := w.tracer.Start(ctx, "api.grpc.webhook.replay.handler", trace.WithAttributes(
+		attribute.String("operation", "replay"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	replayed, err := w.app.Replay(ctx, subscription.ReplayCommand{
+		Id:             request.Id,
+		SubscriptionId: request.SubscriptionId,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		w.log.Error("failed to replay deliveries",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "replay"),
+			zap.Error(err),
+		)
+
+		return nil, parseError(err)
+	}
+
+	w.log.Info("replay deliveries process successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "replay"),
+		zap.Int64("replayed", replayed),
+	)
+
+	return &webhookv1.ReplayResponse{
+		Message:  "deliveries queued for replay",
+		Replayed: replayed,
+	}, nil
+}
+
+func NewWebhook(apps app.Apps, log logger.Logger, tracer tracing.Tracer) *WebhookHandler {
+	return &WebhookHandler{
+		app:    apps.Subscription,
+		log:    log,
+		tracer: tracer,
+	}
+}
@@ -8,10 +8,15 @@ import (
 	"github.com/ose-micro/core/logger"
 	"github.com/ose-micro/core/tracing"
 	oseGrpc "github.com/ose-micro/grpc"
+	adminv1 "github.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/admin/v1"
 	emailv1 "github.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/email/v1"
+	suppressionv1 "github.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/suppression/v1"
 	templatev1 "github.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/template/v1"
+	webhookv1 "github.com/ose-micro/postman/internal/api/grpc/gen/go/ose/micro/postman/webhook/v1"
 	"github.com/ose-micro/postman/internal/api/grpc/handlers"
 	"github.com/ose-micro/postman/internal/app"
+	"github.com/ose-micro/postman/internal/app/email"
+	"github.com/ose-micro/postman/internal/infrastructure/ratelimit"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -19,12 +24,37 @@ import (
 
 type Config struct {
 	Port int64 `mapstructure:"port"`
+	// MaxRecvMsgSize caps one request in bytes. It is raised when the email
+	// attachment limit would not fit in it.
+	MaxRecvMsgSize int `mapstructure:"max_recv_msg_size"`
 }
 
-func RunGRPCServer(lc fx.Lifecycle, conf Config, log logger.Logger, tracer tracing.Tracer, apps app.Apps) (*oseGrpc.Server, error) {
+const (
+	// fits the default 20MiB of attachments of one email, unlike grpc's own 4MiB
+	defaultMaxRecvMsgSize = 32 << 20
+	// room left next to the attachments for the body, recipients and framing
+	messageOverhead = 4 << 20
+)
+
+func (c Config) withDefaults() Config {
+	if c.MaxRecvMsgSize <= 0 {
+		c.MaxRecvMsgSize = defaultMaxRecvMsgSize
+	}
+
+	return c
+}
+
+func RunGRPCServer(lc fx.Lifecycle, conf Config, emailConf email.Config, log logger.Logger, tracer tracing.Tracer,
+	apps app.Apps, limiter *ratelimit.Limiter) (*oseGrpc.Server, error) {
+	conf = conf.withDefaults()
+	if size := int(emailConf.MaxAttachmentsSize) + messageOverhead; size > conf.MaxRecvMsgSize {
+		conf.MaxRecvMsgSize = size
+	}
+
 	svc, err := oseGrpc.New(oseGrpc.Params{
-		Logger: log,
-		Tracer: tracer,
+		Middlewares: []grpc.ServerOption{grpc.MaxRecvMsgSize(conf.MaxRecvMsgSize)},
+		Logger:      log,
+		Tracer:      tracer,
 	})
 	if err != nil {
 		return nil, err
@@ -43,6 +73,9 @@ func RunGRPCServer(lc fx.Lifecycle, conf Config, log logger.Logger, tracer traci
 
 					templatev1.RegisterTemplateServiceServer(s, handlers.NewTemplate(apps, log, tracer))
 					emailv1.RegisterEmailServiceServer(s, handlers.NewEmail(apps, log, tracer))
+					suppressionv1.RegisterSuppressionServiceServer(s, handlers.NewSuppression(apps, log, tracer))
+					adminv1.RegisterAdminServiceServer(s, handlers.NewAdmin(limiter, apps, log, tracer))
+					webhookv1.RegisterWebhookServiceServer(s, handlers.NewWebhook(apps, log, tracer))
 
 				}); err != nil {
 					log.Fatal("gRPC server failed", zap.Error(err))
@@ -0,0 +1,151 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/postman/internal/app"
+	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/postman/internal/business/subscription"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Config struct {
+	// Workers is the number of callbacks posted concurrently.
+	Workers int `mapstructure:"workers"`
+	// Lease is how long a claimed notification or callback is hidden from other workers.
+	Lease time.Duration `mapstructure:"lease"`
+	// Timeout bounds one POST to a subscriber; it must be shorter than Lease.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// Interval is how long an idle worker waits before polling again.
+	Interval time.Duration `mapstructure:"interval"`
+	// MaxAttempts bounds posts per callback before it is marked failed.
+	MaxAttempts int32 `mapstructure:"max_attempts"`
+	// BaseDelay is the wait after the first failure; it doubles per attempt up to MaxDelay.
+	BaseDelay time.Duration `mapstructure:"base_delay"`
+	MaxDelay  time.Duration `mapstructure:"max_delay"`
+}
+
+const (
+	defaultWorkers  = 2
+	defaultLease    = time.Minute
+	defaultTimeout  = 10 * time.Second
+	defaultInterval = time.Second
+
+	defaultMaxAttempts = 8
+	defaultBaseDelay   = 30 * time.Second
+	defaultMaxDelay    = 6 * time.Hour
+)
+
+func (c Config) withDefaults() Config {
+	if c.Workers <= 0 {
+		c.Workers = defaultWorkers
+	}
+
+	if c.Lease <= 0 {
+		c.Lease = defaultLease
+	}
+
+	if c.Timeout <= 0 {
+		c.Timeout = defaultTimeout
+	}
+
+	if c.Interval <= 0 {
+		c.Interval = defaultInterval
+	}
+
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = defaultMaxAttempts
+	}
+
+	if c.BaseDelay <= 0 {
+		c.BaseDelay = defaultBaseDelay
+	}
+
+	if c.MaxDelay <= 0 {
+		c.MaxDelay = defaultMaxDelay
+	}
+
+	return c
+}
+
+func InvokeNotifier(lc fx.Lifecycle, conf Config, apps app.Apps, log logger.Logger) {
+	conf = conf.withDefaults()
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			for i := 0; i < conf.Workers; i++ {
+				wg.Add(1)
+				go func(worker int) {
+					defer wg.Done()
+					work(ctx, worker, conf, apps.Subscription, log)
+				}(i)
+			}
+
+			log.Info("notifier started", zap.Int("workers", conf.Workers))
+			return nil
+		},
+		OnStop: func(stop context.Context) error {
+			cancel()
+
+			done := make(chan struct{})
+			go func() {
+				wg.Wait()
+				close(done)
+			}()
+
+			select {
+			case <-done:
+				log.Info("notifier stopped")
+			case <-stop.Done():
+				log.Error("notifier stopped before workers drained")
+			}
+
+			return nil
+		},
+	})
+}
+
+// work turns queued notifications into callbacks, posts due callbacks, and
+// only sleeps once neither is left or it errors.
+func work(ctx context.Context, worker int, conf Config, app subscription.App, log logger.Logger) {
+	for {
+		notification, notifyErr := app.Notify(ctx, subscription.NotifyCommand{Lease: conf.Lease})
+		if notifyErr != nil {
+			log.Error("notify failed", zap.Int("worker", worker), zap.Error(notifyErr))
+		}
+
+		delivery, err := app.Deliver(ctx, subscription.DeliverCommand{
+			Lease:   conf.Lease,
+			Timeout: conf.Timeout,
+			Retry: email.RetryPolicy{
+				MaxAttempts: conf.MaxAttempts,
+				BaseDelay:   conf.BaseDelay,
+				MaxDelay:    conf.MaxDelay,
+			},
+		})
+		if err != nil {
+			log.Error("deliver failed", zap.Int("worker", worker), zap.Error(err))
+		}
+
+		busy := notifyErr == nil && notification != nil
+		if busy || (err == nil && delivery != nil) {
+			if ctx.Err() != nil {
+				return
+			}
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(conf.Interval):
+		}
+	}
+}
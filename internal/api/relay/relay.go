@@ -0,0 +1,94 @@
+package relay
+
+import (
+	"context"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/postman/internal/app"
+	"github.com/ose-micro/postman/internal/business/event"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Config struct {
+	// Lease is how long a claimed message is hidden from other relays; a
+	// message whose publish failed is retried once it runs out.
+	Lease time.Duration `mapstructure:"lease"`
+	// Interval is how long an idle relay waits before polling the outbox again.
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+const (
+	defaultLease    = 30 * time.Second
+	defaultInterval = 500 * time.Millisecond
+)
+
+func (c Config) withDefaults() Config {
+	if c.Lease <= 0 {
+		c.Lease = defaultLease
+	}
+
+	if c.Interval <= 0 {
+		c.Interval = defaultInterval
+	}
+
+	return c
+}
+
+// InvokeRelay publishes outbox messages onto the bus with a single worker.
+// Messages go out oldest first, but one whose publish failed waits out its
+// lease while later ones are published, so consumers must not rely on order.
+func InvokeRelay(lc fx.Lifecycle, conf Config, apps app.Apps, log logger.Logger) {
+	conf = conf.withDefaults()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go func() {
+				defer close(done)
+				work(ctx, conf, apps.Event, log)
+			}()
+
+			log.Info("relay started")
+			return nil
+		},
+		OnStop: func(stop context.Context) error {
+			cancel()
+
+			select {
+			case <-done:
+				log.Info("relay stopped")
+			case <-stop.Done():
+				log.Error("relay stopped before draining")
+			}
+
+			return nil
+		},
+	})
+}
+
+// work publishes waiting messages and only sleeps once none are left or it errors.
+func work(ctx context.Context, conf Config, app event.App, log logger.Logger) {
+	for {
+		message, err := app.Relay(ctx, event.RelayCommand{Lease: conf.Lease})
+		if err != nil {
+			log.Error("relay failed", zap.Error(err))
+		}
+
+		if err == nil && message != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(conf.Interval):
+		}
+	}
+}
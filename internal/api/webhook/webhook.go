@@ -0,0 +1,216 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/app"
+	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/postman/internal/infrastructure/webhook"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Config struct {
+	// Port the webhook HTTP server listens on. Zero disables it.
+	Port int64 `mapstructure:"port"`
+	// Secrets maps a provider parser name to its signing secret. Only
+	// providers listed here are accepted.
+	Secrets map[string]string `mapstructure:"secrets"`
+	// MaxSize caps an accepted callback body in bytes.
+	MaxSize int64 `mapstructure:"max_size"`
+}
+
+const defaultMaxSize = 1 << 20
+
+func (c Config) withDefaults() Config {
+	if c.MaxSize <= 0 {
+		c.MaxSize = defaultMaxSize
+	}
+
+	return c
+}
+
+type server struct {
+	parsers map[string]webhook.Parser
+	app     email.App
+	maxSize int64
+	log     logger.Logger
+	tracer  tracing.Tracer
+}
+
+func InvokeWebhook(lc fx.Lifecycle, conf Config, apps app.Apps, log logger.Logger, tracer tracing.Tracer) error {
+	conf = conf.withDefaults()
+	if conf.Port == 0 {
+		return nil
+	}
+
+	parsers := make(map[string]webhook.Parser, len(conf.Secrets))
+	for name, secret := range conf.Secrets {
+		parser, err := webhook.New(name, secret)
+		if err != nil {
+			return fmt.Errorf("webhook provider %s: %w", name, err)
+		}
+
+		parsers[name] = parser
+	}
+
+	s := &server{
+		parsers: parsers,
+		app:     apps.Email,
+		maxSize: conf.MaxSize,
+		log:     log,
+		tracer:  tracer,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /webhooks/{provider}", s.handle)
+	httpServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", conf.Port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			listener, err := net.Listen("tcp", httpServer.Addr)
+			if err != nil {
+				return err
+			}
+
+			go func() {
+				if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					log.Error("webhook server stopped", zap.Error(err))
+				}
+			}()
+
+			log.Info(fmt.Sprintf("webhook server listening on :%d", conf.Port))
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return httpServer.Shutdown(ctx)
+		},
+	})
+
+	return nil
+}
+
+// handle verifies a provider callback and applies each of its events. Providers
+// retry on 5xx, so only failures worth retrying answer with one; an event that
+// is invalid or matches no email is logged and skipped.
+func (s *server) handle(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("provider")
+	ctx, span := s.tracer.Start(r.Context(), "api.webhook.handle", trace.WithAttributes(
+		attribute.String("operation", "webhook"),
+		attribute.String("provider", name),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	parser, ok := s.parsers[name]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.maxSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if err := parser.Verify(r.Header, body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("webhook rejected",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "webhook"),
+			zap.String("provider", name),
+			zap.Error(err),
+		)
+
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	events, err := parser.Parse(body)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to parse webhook",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "webhook"),
+			zap.String("provider", name),
+			zap.Error(err),
+		)
+
+		http.Error(w, "malformed payload", http.StatusBadRequest)
+		return
+	}
+
+	skipped := 0
+	for _, event := range events {
+		if _, err := s.app.Event(ctx, email.EventCommand{
+			Type:       email.EventType(event.Type),
+			MessageId:  event.MessageId,
+			ProviderId: event.ProviderId,
+			Recipient:  event.Recipient,
+			Status:     event.Status,
+			Diagnostic: event.Diagnostic,
+			Permanent:  event.Permanent,
+			Source:     name,
+			Url:        event.Url,
+			At:         event.At,
+		}); err != nil {
+			// an invalid or unknown event fails the same way on redelivery, so it
+			// is skipped rather than holding back the rest of the batch
+			var failure *ose_error.Error
+			if errors.As(err, &failure) && (failure.Code == ose_error.ErrBadRequest || failure.Code == ose_error.ErrNotFound) {
+				skipped++
+				s.log.Info("webhook event skipped",
+					zap.String("trace_id", traceId),
+					zap.String("operation", "webhook"),
+					zap.String("provider", name),
+					zap.String("type", string(event.Type)),
+					zap.String("message_id", event.MessageId),
+					zap.String("provider_id", event.ProviderId),
+					zap.Error(err),
+				)
+				continue
+			}
+
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			s.log.Error("failed to apply webhook event",
+				zap.String("trace_id", traceId),
+				zap.String("operation", "webhook"),
+				zap.String("provider", name),
+				zap.Error(err),
+			)
+
+			http.Error(w, "failed to apply event", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	s.log.Info("webhook process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "webhook"),
+		zap.String("provider", name),
+		zap.Int("events", len(events)),
+		zap.Int("skipped", skipped),
+	)
+	w.WriteHeader(http.StatusNoContent)
+}
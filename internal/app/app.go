@@ -5,23 +5,44 @@ import (
 	"github.com/ose-micro/core/logger"
 	"github.com/ose-micro/core/tracing"
 	"github.com/ose-micro/mailer"
+	"github.com/ose-micro/postman/internal/app/deadletter"
 	"github.com/ose-micro/postman/internal/app/email"
+	"github.com/ose-micro/postman/internal/app/event"
+	"github.com/ose-micro/postman/internal/app/subscription"
+	"github.com/ose-micro/postman/internal/app/suppression"
 	"github.com/ose-micro/postman/internal/app/template"
 	"github.com/ose-micro/postman/internal/business"
+	deadletterDomain "github.com/ose-micro/postman/internal/business/deadletter"
 	emailDomain "github.com/ose-micro/postman/internal/business/email"
+	eventDomain "github.com/ose-micro/postman/internal/business/event"
+	subscriptionDomain "github.com/ose-micro/postman/internal/business/subscription"
+	suppressionDomain "github.com/ose-micro/postman/internal/business/suppression"
 	domain_template "github.com/ose-micro/postman/internal/business/template"
+	"github.com/ose-micro/postman/internal/infrastructure/delivery"
+	"github.com/ose-micro/postman/internal/infrastructure/ratelimit"
 	"github.com/ose-micro/postman/internal/infrastructure/repository"
 )
 
 type Apps struct {
-	Template domain_template.App
-	Email    emailDomain.App
+	Template     domain_template.App
+	Email        emailDomain.App
+	Suppression  suppressionDomain.App
+	Subscription subscriptionDomain.App
+	Event        eventDomain.App
+	DeadLetter   deadletterDomain.App
 }
 
 func InjectApps(bs business.Domain, repo repository.Repository, log logger.Logger,
-	tracer tracing.Tracer, bus domain.Bus, mailer *mailer.Mailer) Apps {
+	tracer tracing.Tracer, bus domain.Bus, mailer *mailer.Mailer, transport delivery.Transport, limiter *ratelimit.Limiter, emailConf email.Config) Apps {
+	suppressions := suppression.NewSuppressionApp(bs, log, tracer, repo.Suppression)
+	subscriptions := subscription.NewSubscriptionApp(bs, log, tracer, repo.Subscription)
+
 	return Apps{
-		Template: template.NewTemplateApp(bs, log, tracer, repo.Template, bus, mailer),
-		Email:    email.NewEmailApp(bs, log, tracer, repo, bus, mailer),
+		Template:     template.NewTemplateApp(bs, log, tracer, repo.Template, bus, mailer),
+		Email:        email.NewEmailApp(bs, log, tracer, repo, bus, mailer, transport, limiter, suppressions, emailConf),
+		Suppression:  suppressions,
+		Subscription: subscriptions,
+		Event:        event.NewEventApp(log, tracer, repo.Outbox, bus),
+		DeadLetter:   deadletter.NewDeadLetterApp(log, tracer, repo.DeadLetter, bus),
 	}
 }
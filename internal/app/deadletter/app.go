@@ -0,0 +1,166 @@
+package deadletter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/domain"
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	"github.com/ose-micro/postman/internal/business/deadletter"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type deadLetterApp struct {
+	tracer  tracing.Tracer
+	log     logger.Logger
+	settle  cqrs.CommandHandle[deadletter.SettleCommand, *deadletter.Letter]
+	read    cqrs.QueryHandle[deadletter.ReadQuery, map[string]any]
+	inspect cqrs.QueryHandle[deadletter.InspectQuery, *deadletter.Letter]
+	replay  cqrs.CommandHandle[deadletter.ReplayCommand, *deadletter.Letter]
+	purge   cqrs.CommandHandle[deadletter.PurgeCommand, int64]
+}
+
+// Settle implements deadletter.App.
+func (d *deadLetterApp) Settle(ctx context.Context, command deadletter.SettleCommand) (*deadletter.Letter, error) {
+	ctx, span := d.tracer.Start(ctx, "app.deadletter.settle.command", trace.WithAttributes(
+		attribute.String("operation", "settle"),
+		attribute.String("subject", command.Subject),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := d.settle.Handle(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "settle"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Read implements deadletter.App.
+func (d *deadLetterApp) Read(ctx context.Context, request dto.Request) (map[string]any, error) {
+	ctx, span := d.tracer.Start(ctx, "app.deadletter.read.query", trace.WithAttributes(
+		attribute.String("operation", "read"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := d.read.Handle(ctx, deadletter.ReadQuery{
+		Request: request,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to process query",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Inspect implements deadletter.App.
+func (d *deadLetterApp) Inspect(ctx context.Context, query deadletter.InspectQuery) (*deadletter.Letter, error) {
+	ctx, span := d.tracer.Start(ctx, "app.deadletter.inspect.query", trace.WithAttributes(
+		attribute.String("operation", "inspect"),
+		attribute.String("id", query.Id),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := d.inspect.Handle(ctx, query)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to process query",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "inspect"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Replay implements deadletter.App.
+func (d *deadLetterApp) Replay(ctx context.Context, command deadletter.ReplayCommand) (*deadletter.Letter, error) {
+	ctx, span := d.tracer.Start(ctx, "app.deadletter.replay.command", trace.WithAttributes(
+		attribute.String("operation", "replay"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := d.replay.Handle(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "replay"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Purge implements deadletter.App.
+func (d *deadLetterApp) Purge(ctx context.Context, command deadletter.PurgeCommand) (int64, error) {
+	ctx, span := d.tracer.Start(ctx, "app.deadletter.purge.command", trace.WithAttributes(
+		attribute.String("operation", "purge"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := d.purge.Handle(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "purge"),
+			zap.Error(err),
+		)
+
+		return 0, err
+	}
+
+	return record, nil
+}
+
+func NewDeadLetterApp(log logger.Logger, tracer tracing.Tracer, repo deadletter.Repo, bus domain.Bus) deadletter.App {
+	return &deadLetterApp{
+		tracer:  tracer,
+		log:     log,
+		settle:  newSettleCommandHandler(repo, log, tracer),
+		read:    newReadQueryHandler(repo, log, tracer),
+		inspect: newInspectQueryHandler(repo, log, tracer),
+		replay:  newReplayCommandHandler(repo, log, tracer, bus),
+		purge:   newPurgeCommandHandler(repo, log, tracer),
+	}
+}
@@ -0,0 +1,64 @@
+package deadletter
+
+import (
+	"context"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/deadletter"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type inspectQueryHandler struct {
+	repo   deadletter.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+}
+
+// Handle implements cqrs.QueryHandle.
+func (i *inspectQueryHandler) Handle(ctx context.Context, query deadletter.InspectQuery) (*deadletter.Letter, error) {
+	ctx, span := i.tracer.Start(ctx, "app.deadletter.inspect.query.handler", trace.WithAttributes(
+		attribute.String("operation", "inspect"),
+		attribute.String("id", query.Id),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	if query.Id == "" {
+		err := ose_error.New(ose_error.ErrBadRequest, "id is required", traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	letter, err := i.repo.ReadOne(ctx, query.Id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		i.log.Error("failed to repository dead letter",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "inspect"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return letter, nil
+}
+
+func newInspectQueryHandler(repo deadletter.Repo, log logger.Logger,
+	tracer tracing.Tracer) cqrs.QueryHandle[deadletter.InspectQuery, *deadletter.Letter] {
+	return &inspectQueryHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+	}
+}
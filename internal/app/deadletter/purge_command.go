@@ -0,0 +1,85 @@
+package deadletter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/deadletter"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Handler
+type purgeCommandHandler struct {
+	repo   deadletter.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+}
+
+// Handle implements cqrs.CommandHandle.
+func (p *purgeCommandHandler) Handle(ctx context.Context, command deadletter.PurgeCommand) (int64, error) {
+	ctx, span := p.tracer.Start(ctx, "app.deadletter.purge.command.handler", trace.WithAttributes(
+		attribute.String("operation", "purge"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		p.log.Error("validation process failed",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "purge"),
+			zap.Error(err),
+		)
+
+		return 0, err
+	}
+
+	purged, err := p.repo.Purge(ctx, command.Id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		p.log.Error("failed to purge dead letters",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "purge"),
+			zap.Error(err),
+		)
+
+		return 0, err
+	}
+
+	if command.Id != "" && purged == 0 {
+		err := ose_error.New(ose_error.ErrNotFound, "dead letter not found", traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return 0, err
+	}
+
+	p.log.Info("purge process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "purge"),
+		zap.Int64("purged", purged),
+	)
+	return purged, nil
+}
+
+func newPurgeCommandHandler(repo deadletter.Repo, log logger.Logger,
+	tracer tracing.Tracer) cqrs.CommandHandle[deadletter.PurgeCommand, int64] {
+	return &purgeCommandHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+	}
+}
@@ -0,0 +1,61 @@
+package deadletter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	"github.com/ose-micro/postman/internal/business/deadletter"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type readQueryHandler struct {
+	repo   deadletter.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+}
+
+// Handle implements cqrs.QueryHandle.
+func (r *readQueryHandler) Handle(ctx context.Context, query deadletter.ReadQuery) (map[string]any, error) {
+	ctx, span := r.tracer.Start(ctx, "app.deadletter.read.query.handler", trace.WithAttributes(
+		attribute.String("operation", "read"),
+		attribute.String("payload", fmt.Sprintf("%v", query)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	records, err := r.repo.Read(ctx, query.Request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to repository dead letters",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	r.log.Info("repository process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "read"),
+		zap.Any("payload", fmt.Sprintf("%v", query)),
+	)
+	return records, nil
+}
+
+func newReadQueryHandler(repo deadletter.Repo, log logger.Logger,
+	tracer tracing.Tracer) cqrs.QueryHandle[deadletter.ReadQuery, map[string]any] {
+	return &readQueryHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+	}
+}
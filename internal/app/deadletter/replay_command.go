@@ -0,0 +1,119 @@
+package deadletter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ose-micro/core/domain"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/deadletter"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Handler
+type replayCommandHandler struct {
+	repo   deadletter.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+	bus    domain.Bus
+}
+
+// Handle implements cqrs.CommandHandle. The letter is kept, marked replayed,
+// so a replay that fails again leaves a second letter rather than losing the first.
+func (r *replayCommandHandler) Handle(ctx context.Context, command deadletter.ReplayCommand) (*deadletter.Letter, error) {
+	ctx, span := r.tracer.Start(ctx, "app.deadletter.replay.command.handler", trace.WithAttributes(
+		attribute.String("operation", "replay"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("validation process failed",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "replay"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	letter, err := r.repo.ReadOne(ctx, command.Id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to repository dead letter",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "replay"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	// the bus encodes what it is given, so only JSON can go out unchanged
+	if !json.Valid([]byte(letter.Payload)) {
+		err := ose_error.New(ose_error.ErrBadRequest, "dead letter payload is not JSON and cannot be replayed", traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	if err := r.bus.Publish(letter.Subject, json.RawMessage(letter.Payload)); err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to publish dead letter",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "replay"),
+			zap.String("id", letter.Id),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	letter.Replayed()
+	if err := r.repo.Update(ctx, *letter); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to update dead letter",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "replay"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	r.log.Info("replay process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "replay"),
+		zap.String("id", letter.Id),
+		zap.String("subject", letter.Subject),
+	)
+	return letter, nil
+}
+
+func newReplayCommandHandler(repo deadletter.Repo, log logger.Logger, tracer tracing.Tracer,
+	bus domain.Bus) cqrs.CommandHandle[deadletter.ReplayCommand, *deadletter.Letter] {
+	return &replayCommandHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+		bus:    bus,
+	}
+}
@@ -0,0 +1,99 @@
+package deadletter
+
+import (
+	"context"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/deadletter"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Handler
+type settleCommandHandler struct {
+	repo   deadletter.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+}
+
+// Handle implements cqrs.CommandHandle.
+func (s *settleCommandHandler) Handle(ctx context.Context, command deadletter.SettleCommand) (*deadletter.Letter, error) {
+	ctx, span := s.tracer.Start(ctx, "app.deadletter.settle.command.handler", trace.WithAttributes(
+		attribute.String("operation", "settle"),
+		attribute.String("subject", command.Subject),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("validation process failed",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "settle"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	if command.Failure == nil {
+		return nil, nil
+	}
+
+	kind := deadletter.KindExhausted
+	switch {
+	case deadletter.Poison(command.Failure):
+		kind = deadletter.KindPoison
+	case command.Delivered < command.MaxDeliver:
+		s.log.Info("message will be delivered again",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "settle"),
+			zap.String("subject", command.Subject),
+			zap.Int32("delivered", command.Delivered),
+			zap.Error(command.Failure),
+		)
+
+		return nil, nil
+	}
+
+	letter := deadletter.NewLetter(command.Subject, command.Payload, command.Failure.Error(), kind, command.Delivered)
+	if err := s.repo.Create(ctx, letter); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to save dead letter",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "settle"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	s.log.Info("settle process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "settle"),
+		zap.String("id", letter.Id),
+		zap.String("subject", letter.Subject),
+		zap.String("kind", string(letter.Kind)),
+		zap.Int32("attempts", letter.Attempts),
+	)
+	return &letter, nil
+}
+
+func newSettleCommandHandler(repo deadletter.Repo, log logger.Logger,
+	tracer tracing.Tracer) cqrs.CommandHandle[deadletter.SettleCommand, *deadletter.Letter] {
+	return &settleCommandHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+	}
+}
@@ -12,6 +12,9 @@ import (
 	"github.com/ose-micro/mailer"
 	"github.com/ose-micro/postman/internal/business"
 	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/postman/internal/business/suppression"
+	"github.com/ose-micro/postman/internal/infrastructure/delivery"
+	"github.com/ose-micro/postman/internal/infrastructure/ratelimit"
 	"github.com/ose-micro/postman/internal/infrastructure/repository"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -20,11 +23,15 @@ import (
 )
 
 type emailApp struct {
-	log    logger.Logger
-	tracer tracing.Tracer
-	create cqrs.CommandHandle[email.CreateCommand, *email.Domain]
-	resend cqrs.CommandHandle[email.IdCommand, *email.Domain]
-	read   cqrs.QueryHandle[email.ReadQuery, map[string]any]
+	log      logger.Logger
+	tracer   tracing.Tracer
+	create   cqrs.CommandHandle[email.CreateCommand, *email.Domain]
+	resend   cqrs.CommandHandle[email.IdCommand, *email.Domain]
+	dispatch cqrs.CommandHandle[email.DispatchCommand, *email.Domain]
+	release  cqrs.CommandHandle[email.ReleaseCommand, int64]
+	cancel   cqrs.CommandHandle[email.IdCommand, *email.Domain]
+	event    cqrs.CommandHandle[email.EventCommand, *email.Domain]
+	read     cqrs.QueryHandle[email.ReadQuery, map[string]any]
 }
 
 // Create implements email.App.
@@ -104,13 +111,120 @@ func (r *emailApp) Resend(ctx context.Context, command email.IdCommand) error {
 	return nil
 }
 
+// Dispatch implements email.App.
+func (d *emailApp) Dispatch(ctx context.Context, command email.DispatchCommand) (*email.Domain, error) {
+	ctx, span := d.tracer.Start(ctx, "app.email.dispatch.command", trace.WithAttributes(
+		attribute.String("operation", "dispatch"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := d.dispatch.Handle(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "dispatch"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Release implements email.App.
+func (e *emailApp) Release(ctx context.Context, command email.ReleaseCommand) (int64, error) {
+	ctx, span := e.tracer.Start(ctx, "app.email.release.command", trace.WithAttributes(
+		attribute.String("operation", "release"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	released, err := e.release.Handle(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		e.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "release"),
+			zap.Error(err),
+		)
+
+		return 0, err
+	}
+
+	return released, nil
+}
+
+// Cancel implements email.App.
+func (e *emailApp) Cancel(ctx context.Context, command email.IdCommand) (*email.Domain, error) {
+	ctx, span := e.tracer.Start(ctx, "app.email.cancel.command", trace.WithAttributes(
+		attribute.String("operation", "cancel"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := e.cancel.Handle(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		e.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "cancel"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Event implements email.App.
+func (e *emailApp) Event(ctx context.Context, command email.EventCommand) (*email.Domain, error) {
+	ctx, span := e.tracer.Start(ctx, "app.email.event.command", trace.WithAttributes(
+		attribute.String("operation", "event"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := e.event.Handle(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		e.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "event"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
 func NewEmailApp(bs business.Domain, log logger.Logger, tracer tracing.Tracer,
-	read repository.Repository, bus domain.Bus, mailer *mailer.Mailer) email.App {
+	read repository.Repository, bus domain.Bus, mailer *mailer.Mailer, transport delivery.Transport, limiter *ratelimit.Limiter,
+	suppressions suppression.App, conf Config) email.App {
+	conf = conf.withDefaults()
+
 	return &emailApp{
-		log:    log,
-		tracer: tracer,
-		create: newCreateCommandHandler(bs, read, log, tracer, bus, mailer),
-		resend: newResendCommandHandler(bs, read, log, tracer, bus, mailer),
-		read:   newReadQueryHandler(read.Email, log, tracer),
+		log:      log,
+		tracer:   tracer,
+		create:   newCreateCommandHandler(bs, read, log, tracer, bus, mailer, suppressions, conf),
+		resend:   newResendCommandHandler(bs, read, log, tracer, bus),
+		dispatch: newDispatchCommandHandler(read, log, tracer, transport, limiter, suppressions, conf),
+		release:  newReleaseCommandHandler(read, log, tracer),
+		cancel:   newCancelCommandHandler(read, log, tracer),
+		event:    newEventCommandHandler(read, log, tracer, suppressions),
+		read:     newReadQueryHandler(read.Email, log, tracer),
 	}
 }
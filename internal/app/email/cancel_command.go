@@ -0,0 +1,119 @@
+package email
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/postman/internal/infrastructure/repository"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Handler
+type cancelCommandHandler struct {
+	repo   repository.Repository
+	log    logger.Logger
+	tracer tracing.Tracer
+}
+
+// Handle implements cqrs.CommandHandle.
+func (c *cancelCommandHandler) Handle(ctx context.Context, command email.IdCommand) (*email.Domain, error) {
+	ctx, span := c.tracer.Start(ctx, "app.email.cancel.command.handler", trace.WithAttributes(
+		attribute.String("operation", "cancel"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.log.Error("validation process failed",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "cancel"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	record, err := c.repo.Email.ReadOne(ctx, dto.Request{
+		Queries: []dto.Query{
+			{
+				Name: "one",
+				Filters: []dto.Filter{
+					{
+						Field: "_id",
+						Op:    dto.OpEq,
+						Value: command.Id,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.log.Error("failed to repository email",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "cancel"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	from := record.State()
+	if err := record.Transition(email.StateCancelled); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrConflict, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.log.Error("failed to cancel email",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "cancel"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	// a dispatcher may claim the email between the read and this write
+	if err := c.repo.Email.UpdateFrom(ctx, *record, from); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.log.Error("failed to update email",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "cancel"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	c.log.Info("cancel process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "cancel"),
+		zap.Any("payload", command),
+	)
+	return record, nil
+}
+
+func newCancelCommandHandler(repo repository.Repository, log logger.Logger,
+	tracer tracing.Tracer) cqrs.CommandHandle[email.IdCommand, *email.Domain] {
+	return &cancelCommandHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+	}
+}
@@ -0,0 +1,40 @@
+package email
+
+import "time"
+
+type Config struct {
+	// IdempotencyWindow is how long a create with a repeated idempotency key
+	// returns the original email instead of sending again.
+	IdempotencyWindow time.Duration `mapstructure:"idempotency_window"`
+	// MaxAttachmentSize caps a single attachment in bytes.
+	MaxAttachmentSize int64 `mapstructure:"max_attachment_size"`
+	// MaxAttachmentsSize caps the attachments of one email together, in bytes.
+	MaxAttachmentsSize int64 `mapstructure:"max_attachments_size"`
+	// MessageIdDomain is the right-hand side of generated Message-IDs. The
+	// sender's domain is used when it is empty.
+	MessageIdDomain string `mapstructure:"message_id_domain"`
+}
+
+const (
+	defaultIdempotencyWindow = 24 * time.Hour
+
+	// blobs are stored as single Mongo documents, which may not exceed 16MiB
+	defaultMaxAttachmentSize  = 10 << 20
+	defaultMaxAttachmentsSize = 20 << 20
+)
+
+func (c Config) withDefaults() Config {
+	if c.IdempotencyWindow <= 0 {
+		c.IdempotencyWindow = defaultIdempotencyWindow
+	}
+
+	if c.MaxAttachmentSize <= 0 {
+		c.MaxAttachmentSize = defaultMaxAttachmentSize
+	}
+
+	if c.MaxAttachmentsSize <= 0 {
+		c.MaxAttachmentsSize = defaultMaxAttachmentsSize
+	}
+
+	return c
+}
@@ -3,6 +3,11 @@ package email
 import (
 	"context"
 	"fmt"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/ose-micro/core/domain"
 	"github.com/ose-micro/core/dto"
@@ -13,6 +18,8 @@ import (
 	"github.com/ose-micro/mailer"
 	"github.com/ose-micro/postman/internal/business"
 	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/postman/internal/business/suppression"
+	"github.com/ose-micro/postman/internal/business/template"
 	"github.com/ose-micro/postman/internal/infrastructure/repository"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -22,12 +29,14 @@ import (
 
 // Handler
 type createCommandHandler struct {
-	repo   repository.Repository
-	log    logger.Logger
-	mailer *mailer.Mailer
-	bus    domain.Bus
-	tracer tracing.Tracer
-	bs     business.Domain
+	repo         repository.Repository
+	log          logger.Logger
+	mailer       *mailer.Mailer
+	bus          domain.Bus
+	tracer       tracing.Tracer
+	bs           business.Domain
+	suppressions suppression.App
+	conf         Config
 }
 
 // Handle implements cqrs.CommandHandle.
@@ -54,20 +63,39 @@ func (c *createCommandHandler) Handle(ctx context.Context, command email.CreateC
 		return nil, err
 	}
 
-	temp, err := c.repo.Template.ReadOne(ctx, dto.Request{
-		Queries: []dto.Query{
-			{
-				Name: "one",
-				Filters: []dto.Filter{
-					{
-						Field: "_id",
-						Op:    dto.OpEq,
-						Value: command.Template,
-					},
-				},
-			},
-		},
-	})
+	// stale is the email holding the key past its window; the key moves to
+	// the new email in the transaction that saves it
+	stale := ""
+	if command.IdempotencyKey != "" {
+		original, err := c.repo.Email.ReadOne(ctx, idempotencyRequest(command.IdempotencyKey))
+		if err != nil && !isNotFound(err) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			c.log.Error("failed to read email by idempotency key",
+				zap.String("trace_id", traceId),
+				zap.String("operation", "create"),
+				zap.Error(err),
+			)
+
+			return nil, err
+		}
+
+		if original != nil {
+			if time.Since(original.CreatedAt()) < c.conf.IdempotencyWindow {
+				c.log.Info("create process deduplicated by idempotency key",
+					zap.String("trace_id", traceId),
+					zap.String("operation", "create"),
+					zap.String("id", original.ID()),
+				)
+
+				return original, nil
+			}
+
+			stale = original.ID()
+		}
+	}
+
+	temp, err := template.Lookup(ctx, c.repo.Template, command.Template)
 
 	if err != nil {
 		span.RecordError(err)
@@ -81,7 +109,7 @@ func (c *createCommandHandler) Handle(ctx context.Context, command email.CreateC
 		return nil, err
 	}
 
-	if err := c.mailer.ValidateMapData(temp.Content(), command.Data); err != nil {
+	if err := c.mailer.ValidateMapData(strings.Join([]string{temp.Subject(), temp.HtmlContent(), temp.TextContent()}, "\n"), command.Data); err != nil {
 		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -94,32 +122,21 @@ func (c *createCommandHandler) Handle(ctx context.Context, command email.CreateC
 		return nil, err
 	}
 
-	state := email.StateFailed
-	err = c.mailer.Send(ctx, mailer.Params{
-		Sender:    command.Sender,
-		Recipient: command.Recipient,
-		Subject:   temp.Subject(),
-		Message:   temp.Content(),
-		Data:      command.Data,
-		From:      command.From,
-	})
-
+	subject, err := c.mailer.Rerendered(temp.Subject(), command.Data)
 	if err != nil {
-		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		err = ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		c.log.Error("failed to send mail",
+		c.log.Error("failed to render subject",
 			zap.String("trace_id", traceId),
 			zap.String("operation", "create"),
 			zap.Error(err),
 		)
 
-		state = email.StateFailed
-	} else {
-		state = email.StateComplete
+		return nil, err
 	}
 
-	message, err := c.mailer.Rerendered(temp.Content(), command.Data)
+	message, err := c.mailer.Rerendered(temp.HtmlContent(), command.Data)
 	if err != nil {
 		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
 		span.RecordError(err)
@@ -133,16 +150,116 @@ func (c *createCommandHandler) Handle(ctx context.Context, command email.CreateC
 		return nil, err
 	}
 
+	// without an authored text body the alternative is derived from the rendered html
+	text := template.PlainText(message)
+	if temp.TextContent() != "" {
+		text, err = c.mailer.Rerendered(temp.TextContent(), command.Data)
+		if err != nil {
+			err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			c.log.Error("failed to render text",
+				zap.String("trace_id", traceId),
+				zap.String("operation", "create"),
+				zap.Error(err),
+			)
+
+			return nil, err
+		}
+	}
+
+	attachments, blobs, err := c.attachments(ctx, command.Attachments, traceId)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.log.Error("failed to store attachments",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "create"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	// blobs stored for this email are removed again unless the email is saved
+	saved := false
+	defer func() {
+		if !saved {
+			c.deleteBlobs(ctx, blobs, traceId)
+		}
+	}()
+
+	to := command.To
+	if command.Recipient != "" {
+		to = append([]string{command.Recipient}, command.To...)
+	}
+
+	state := email.StateQueued
+	if command.SendAt != nil && command.SendAt.After(time.Now()) {
+		state = email.StateScheduled
+	}
+
+	cc, bcc := command.Cc, command.Bcc
+	blocked, err := suppressedRecipients(ctx, c.suppressions, command.Category, to, cc, bcc)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.log.Error("failed to check suppressions",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "create"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	// suppressed recipients are dropped; once no To recipient is left the copies
+	// have nothing to accompany, so the email is kept but never sent
+	var suppressed []string
+	var lastError string
+	if len(blocked) > 0 {
+		suppressed = append(suppressed, suppressedOf(to, blocked)...)
+		suppressed = append(suppressed, suppressedOf(cc, blocked)...)
+		suppressed = append(suppressed, suppressedOf(bcc, blocked)...)
+
+		if kept := unsuppressed(to, blocked); len(kept) > 0 {
+			to, cc, bcc = kept, unsuppressed(cc, blocked), unsuppressed(bcc, blocked)
+		} else {
+			state = email.StateSuppressed
+			lastError = "every to recipient is suppressed"
+		}
+
+		c.log.Info("suppressed recipients dropped",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "create"),
+			zap.Strings("suppressed", suppressed),
+		)
+	}
+
 	// create business
 	record, err := c.bs.Email.New(email.Params{
-		Recipient: command.Recipient,
-		Sender:    command.Sender,
-		Subject:   temp.Subject(),
-		Data:      command.Data,
-		Template:  command.Template,
-		From:      command.From,
-		Message:   message,
-		State:     state,
+		Recipient:       to[0],
+		To:              to,
+		Cc:              cc,
+		Bcc:             bcc,
+		ReplyTo:         command.ReplyTo,
+		Sender:          command.Sender,
+		Subject:         subject,
+		Data:            command.Data,
+		Template:        temp.ID(),
+		TemplateVersion: temp.Version(),
+		From:            command.From,
+		Message:         message,
+		Text:            text,
+		State:           state,
+		SendAt:          command.SendAt,
+		IdempotencyKey:  command.IdempotencyKey,
+		Attachments:     attachments,
+		Headers:         command.Headers,
+		Tags:            command.Tags,
+		Category:        command.Category,
+		Suppressed:      suppressed,
+		LastError:       lastError,
 	})
 	if err != nil {
 		span.RecordError(err)
@@ -157,8 +274,28 @@ func (c *createCommandHandler) Handle(ctx context.Context, command email.CreateC
 	}
 
 	// save role to write store
-	err = c.repo.Email.Create(ctx, *record)
+	if stale != "" {
+		err = c.repo.Email.Supersede(ctx, *record, stale)
+	} else {
+		err = c.repo.Email.Create(ctx, *record)
+	}
 	if err != nil {
+		// a concurrent create with the same key won the insert
+		if command.IdempotencyKey != "" {
+			original, readErr := c.repo.Email.ReadOne(ctx, idempotencyRequest(command.IdempotencyKey))
+			if original != nil {
+				return original, nil
+			}
+
+			if readErr != nil && !isNotFound(readErr) {
+				c.log.Error("failed to read email by idempotency key",
+					zap.String("trace_id", traceId),
+					zap.String("operation", "create"),
+					zap.Error(readErr),
+				)
+			}
+		}
+
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		c.log.Error("fail while saving business",
@@ -169,6 +306,7 @@ func (c *createCommandHandler) Handle(ctx context.Context, command email.CreateC
 
 		return nil, err
 	}
+	saved = true
 
 	c.log.Info("create process complete successfully",
 		zap.String("trace_id", traceId),
@@ -178,14 +316,116 @@ func (c *createCommandHandler) Handle(ctx context.Context, command email.CreateC
 	return record, nil
 }
 
+// attachments enforces the size limits and stores new attachment bytes as blobs,
+// returning the metadata kept on the email and the ids of the blobs it stored.
+func (c *createCommandHandler) attachments(ctx context.Context, uploads []email.NewAttachment, traceId string) ([]email.Attachment, []string, error) {
+	attachments := make([]email.Attachment, 0, len(uploads))
+	var total int64
+
+	for _, upload := range uploads {
+		content, blob := upload.Content, upload.Blob
+		if blob != "" {
+			stored, err := c.repo.Email.ReadBlob(ctx, blob)
+			if err != nil {
+				return nil, nil, err
+			}
+			content = stored
+		}
+
+		size := int64(len(content))
+		if size > c.conf.MaxAttachmentSize {
+			return nil, nil, ose_error.New(ose_error.ErrBadRequest,
+				fmt.Sprintf("attachment %s exceeds %d bytes", upload.Filename, c.conf.MaxAttachmentSize), traceId)
+		}
+
+		if total += size; total > c.conf.MaxAttachmentsSize {
+			return nil, nil, ose_error.New(ose_error.ErrBadRequest,
+				fmt.Sprintf("attachments exceed %d bytes in total", c.conf.MaxAttachmentsSize), traceId)
+		}
+
+		contentType := upload.ContentType
+		if contentType == "" {
+			contentType = mime.TypeByExtension(filepath.Ext(upload.Filename))
+		}
+		if contentType == "" {
+			contentType = http.DetectContentType(content)
+		}
+
+		attachments = append(attachments, email.Attachment{
+			Filename:    upload.Filename,
+			ContentType: contentType,
+			Size:        size,
+			Blob:        blob,
+			ContentId:   upload.ContentId,
+			Inline:      upload.Inline,
+		})
+	}
+
+	// blobs are written only once every attachment passed the limits
+	blobs := make([]string, 0)
+	for i, upload := range uploads {
+		if attachments[i].Blob != "" {
+			continue
+		}
+
+		blob, err := c.repo.Email.CreateBlob(ctx, upload.Content)
+		if err != nil {
+			c.deleteBlobs(ctx, blobs, traceId)
+			return nil, nil, err
+		}
+		attachments[i].Blob = blob
+		blobs = append(blobs, blob)
+	}
+
+	return attachments, blobs, nil
+}
+
+// deleteBlobs removes blobs stored for an email that was never saved. It runs
+// detached from ctx so a cancelled request still cleans up after itself.
+func (c *createCommandHandler) deleteBlobs(ctx context.Context, blobs []string, traceId string) {
+	if len(blobs) == 0 {
+		return
+	}
+
+	if err := c.repo.Email.DeleteBlobs(context.WithoutCancel(ctx), blobs); err != nil {
+		c.log.Error("failed to delete orphaned blobs",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "create"),
+			zap.Strings("blobs", blobs),
+			zap.Error(err),
+		)
+	}
+}
+
+// idempotencyRequest looks an email up by the idempotency key it was created with.
+func idempotencyRequest(key string) dto.Request {
+	return dto.Request{
+		Queries: []dto.Query{
+			{
+				Name: "one",
+				Filters: []dto.Filter{
+					{
+						Field: "idempotency_key",
+						Op:    dto.OpEq,
+						Value: key,
+					},
+				},
+			},
+		},
+	}
+}
+
 func newCreateCommandHandler(bs business.Domain, repo repository.Repository, log logger.Logger,
-	tracer tracing.Tracer, bus domain.Bus, mailer *mailer.Mailer) cqrs.CommandHandle[email.CreateCommand, *email.Domain] {
+	tracer tracing.Tracer, bus domain.Bus, mailer *mailer.Mailer, suppressions suppression.App,
+	conf Config) cqrs.CommandHandle[email.CreateCommand, *email.Domain] {
 	return &createCommandHandler{
-		repo:   repo,
-		log:    log,
-		tracer: tracer,
-		bus:    bus,
-		bs:     bs,
-		mailer: mailer,
+		repo:         repo,
+		log:          log,
+		tracer:       tracer,
+		bus:          bus,
+		bs:           bs,
+		mailer:       mailer,
+		suppressions: suppressions,
+		conf:         conf,
 	}
 }
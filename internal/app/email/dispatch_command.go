@@ -0,0 +1,417 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/postman/internal/business/suppression"
+	"github.com/ose-micro/postman/internal/business/template"
+	"github.com/ose-micro/postman/internal/infrastructure/delivery"
+	"github.com/ose-micro/postman/internal/infrastructure/ratelimit"
+	"github.com/ose-micro/postman/internal/infrastructure/repository"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// settleTimeout bounds saving a dispatch outcome once the send is over.
+const settleTimeout = 10 * time.Second
+
+// Handler
+type dispatchCommandHandler struct {
+	repo         repository.Repository
+	log          logger.Logger
+	transport    delivery.Transport
+	limiter      *ratelimit.Limiter
+	tracer       tracing.Tracer
+	suppressions suppression.App
+	conf         Config
+}
+
+// Handle implements cqrs.CommandHandle.
+func (d *dispatchCommandHandler) Handle(ctx context.Context, command email.DispatchCommand) (*email.Domain, error) {
+	ctx, span := d.tracer.Start(ctx, "app.email.dispatch.command.handler", trace.WithAttributes(
+		attribute.String("operation", "dispatch"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("validation process failed",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "dispatch"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	record, lease, err := d.repo.Email.Claim(ctx, command.Lease)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to claim email",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "dispatch"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	if record == nil {
+		return nil, nil
+	}
+
+	// an address may have been suppressed since the email was created, resent or scheduled
+	blocked, err := suppressedRecipients(ctx, d.suppressions, record.Category(), record.To(), record.Cc(), record.Bcc())
+	if err != nil {
+		span.RecordError(err)
+		d.log.Error("failed to check suppressions",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "dispatch"),
+			zap.String("id", record.ID()),
+			zap.Error(err),
+		)
+
+		return d.throttle(ctx, record, *lease, err)
+	}
+
+	if len(blocked) > 0 {
+		if err := d.suppress(ctx, record, blocked); err != nil {
+			return nil, err
+		}
+
+		if record.State() == email.StateSuppressed {
+			if err := d.settle(ctx, record, *lease); err != nil {
+				return nil, err
+			}
+
+			return record, nil
+		}
+	}
+
+	limits := d.limits(record)
+	if err := d.limiter.Take(limits...); err != nil {
+		return d.throttle(ctx, record, *lease, err)
+	}
+
+	// records created before text bodies were stored fall back to a derived alternative
+	text := record.Text()
+	if text == "" {
+		text = template.PlainText(record.Message())
+	}
+
+	messageId := record.NextMessageId(d.messageIdDomain(record.Sender()))
+
+	// the send gives up early enough to leave the rest of the lease for saving its outcome
+	send, cancel := context.WithTimeout(ctx, command.Lease*3/4)
+	defer cancel()
+
+	started := time.Now()
+	var receipt delivery.Receipt
+
+	// an attachment that cannot be read fails the attempt like a rejected send
+	attachments, err := d.attachments(send, record)
+	if err == nil {
+		receipt, err = delivery.Deliver(send, d.transport, delivery.Message{
+			MessageId: messageId,
+			Sender:    record.Sender(),
+			To:        record.To(),
+			Cc:        record.Cc(),
+			Bcc:       record.Bcc(),
+			ReplyTo:   record.ReplyTo(),
+			Subject:   record.Subject(),
+			Html:      record.Message(),
+			Text:      text,
+			From:      record.From(),
+
+			Headers:     record.Headers(),
+			Attachments: attachments,
+		})
+	}
+
+	// nothing reached a provider, so the email waits without spending an attempt
+	// and hands back the sender and domain tokens it took
+	var throttled *ratelimit.ThrottledError
+	if errors.As(err, &throttled) || errors.Is(err, delivery.ErrUnavailable) {
+		d.limiter.Refund(limits...)
+		return d.throttle(ctx, record, *lease, err)
+	}
+
+	code, response := delivery.Reply(err)
+	attempt := email.Attempt{
+		At:         started,
+		Provider:   receipt.Provider,
+		Code:       int32(code),
+		Response:   response,
+		DurationMs: time.Since(started).Milliseconds(),
+		MessageId:  messageId,
+		ProviderId: receipt.ProviderId,
+	}
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+	record.RecordAttempt(attempt)
+
+	var transition error
+	switch {
+	case err == nil:
+		transition = record.Sent(receipt.Provider)
+	case delivery.IsPermanent(err) || isNotFound(err) || command.Retry.Exhausted(record.Tries()):
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to send mail, giving up",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "dispatch"),
+			zap.String("id", record.ID()),
+			zap.Int32("tries", record.Tries()),
+			zap.Error(err),
+		)
+
+		transition = record.Fail(err.Error())
+	default:
+		retryAt := time.Now().Add(command.Retry.Backoff(record.Tries()))
+		span.RecordError(err)
+		d.log.Error("failed to send mail, retrying",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "dispatch"),
+			zap.String("id", record.ID()),
+			zap.Int32("tries", record.Tries()),
+			zap.Time("retry_at", retryAt),
+			zap.Error(err),
+		)
+
+		transition = record.Retry(retryAt, err.Error())
+	}
+
+	if transition != nil {
+		err := ose_error.Wrap(transition, ose_error.ErrConflict, transition.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to transition email",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "dispatch"),
+			zap.String("id", record.ID()),
+			zap.Error(err),
+		)
+
+		// The send happened, so its attempt is saved and the lease released all
+		// the same. Left in Sending without a lease the email is never claimed,
+		// and so never sent, again.
+		if settled := d.settle(ctx, record, *lease); settled != nil {
+			return nil, settled
+		}
+
+		return nil, err
+	}
+
+	if err := d.settle(ctx, record, *lease); err != nil {
+		return nil, err
+	}
+
+	d.log.Info("dispatch process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "dispatch"),
+		zap.String("id", record.ID()),
+		zap.String("state", string(record.State())),
+	)
+	return record, nil
+}
+
+// attachments reads the bytes of every attachment back from the blob store.
+func (d *dispatchCommandHandler) attachments(ctx context.Context, record *email.Domain) ([]delivery.Attachment, error) {
+	attachments := make([]delivery.Attachment, 0, len(record.Attachments()))
+	for _, attachment := range record.Attachments() {
+		content, err := d.repo.Email.ReadBlob(ctx, attachment.Blob)
+		if err != nil {
+			return nil, fmt.Errorf("attachment %s: %w", attachment.Filename, err)
+		}
+
+		attachments = append(attachments, delivery.Attachment{
+			Filename:    attachment.Filename,
+			ContentType: attachment.ContentType,
+			Content:     content,
+			ContentId:   attachment.ContentId,
+			Inline:      attachment.Inline,
+		})
+	}
+
+	return attachments, nil
+}
+
+// isNotFound reports whether err says something the email needs does not exist.
+func isNotFound(err error) bool {
+	var failure *ose_error.Error
+	return errors.As(err, &failure) && failure.Code == ose_error.ErrNotFound
+}
+
+// limits are the sender and recipient domain buckets an email draws from.
+func (d *dispatchCommandHandler) limits(record *email.Domain) []ratelimit.Key {
+	keys := []ratelimit.Key{ratelimit.Sender(record.Sender())}
+	seen := make(map[ratelimit.Key]bool)
+	for _, list := range [][]string{record.To(), record.Cc(), record.Bcc()} {
+		for _, recipient := range list {
+			if address, err := mail.ParseAddress(recipient); err == nil {
+				recipient = address.Address
+			}
+
+			key := ratelimit.Domain(recipient)
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	return keys
+}
+
+// throttle queues an email that was rate limited, or found every provider's
+// circuit open, again once it should get through. It is not a delivery
+// attempt, so neither tries nor history change.
+func (d *dispatchCommandHandler) throttle(ctx context.Context, record *email.Domain, lease email.Lease, err error) (*email.Domain, error) {
+	span := trace.SpanFromContext(ctx)
+	traceId := span.SpanContext().TraceID().String()
+
+	wait := time.Second
+	var throttled *ratelimit.ThrottledError
+	var unavailable *delivery.UnavailableError
+	switch {
+	case errors.As(err, &throttled) && throttled.Wait > 0:
+		wait = throttled.Wait
+	case errors.As(err, &unavailable) && unavailable.Wait > 0:
+		wait = unavailable.Wait
+	}
+
+	retryAt := time.Now().Add(wait)
+	if err := record.Defer(retryAt); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrConflict, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to transition email",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "dispatch"),
+			zap.String("id", record.ID()),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	if err := d.settle(ctx, record, lease); err != nil {
+		return nil, err
+	}
+
+	d.log.Info("email deferred, queued again",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "dispatch"),
+		zap.String("id", record.ID()),
+		zap.Time("retry_at", retryAt),
+		zap.String("reason", err.Error()),
+	)
+
+	return record, nil
+}
+
+// suppress drops the blocked recipients before sending, moving the email to
+// Suppressed when no To recipient is left.
+func (d *dispatchCommandHandler) suppress(ctx context.Context, record *email.Domain, blocked map[string]bool) error {
+	span := trace.SpanFromContext(ctx)
+	traceId := span.SpanContext().TraceID().String()
+
+	dropped := append(suppressedOf(record.To(), blocked), suppressedOf(record.Cc(), blocked)...)
+	dropped = append(dropped, suppressedOf(record.Bcc(), blocked)...)
+
+	if err := record.Suppress(dropped); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrConflict, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to transition email",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "dispatch"),
+			zap.String("id", record.ID()),
+			zap.Error(err),
+		)
+
+		return err
+	}
+
+	d.log.Info("suppressed recipients dropped",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "dispatch"),
+		zap.String("id", record.ID()),
+		zap.Strings("suppressed", dropped),
+	)
+
+	return nil
+}
+
+// settle saves the outcome of a dispatch, which also releases the lease. It
+// runs detached from ctx so a dispatcher shutting down still records a send
+// that already happened.
+func (d *dispatchCommandHandler) settle(ctx context.Context, record *email.Domain, lease email.Lease) error {
+	span := trace.SpanFromContext(ctx)
+	traceId := span.SpanContext().TraceID().String()
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), settleTimeout)
+	defer cancel()
+
+	if err := d.repo.Email.Settle(ctx, *record, lease); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to update email",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "dispatch"),
+			zap.String("id", record.ID()),
+			zap.Error(err),
+		)
+
+		return err
+	}
+
+	return nil
+}
+
+// messageIdDomain prefers the configured domain and otherwise borrows the sender's.
+func (d *dispatchCommandHandler) messageIdDomain(sender string) string {
+	if d.conf.MessageIdDomain != "" {
+		return d.conf.MessageIdDomain
+	}
+
+	if at := strings.LastIndex(sender, "@"); at >= 0 && at < len(sender)-1 {
+		return sender[at+1:]
+	}
+
+	return "localhost"
+}
+
+func newDispatchCommandHandler(repo repository.Repository, log logger.Logger, tracer tracing.Tracer,
+	transport delivery.Transport, limiter *ratelimit.Limiter, suppressions suppression.App,
+	conf Config) cqrs.CommandHandle[email.DispatchCommand, *email.Domain] {
+	return &dispatchCommandHandler{
+		repo:         repo,
+		log:          log,
+		transport:    transport,
+		limiter:      limiter,
+		tracer:       tracer,
+		suppressions: suppressions,
+		conf:         conf,
+	}
+}
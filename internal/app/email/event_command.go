@@ -0,0 +1,243 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/postman/internal/business/suppression"
+	"github.com/ose-micro/postman/internal/infrastructure/repository"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Handler
+type eventCommandHandler struct {
+	repo         repository.Repository
+	log          logger.Logger
+	tracer       tracing.Tracer
+	suppressions suppression.App
+}
+
+// Handle implements cqrs.CommandHandle. It returns nil when no email matches the
+// event, which is normal for mail sent by other systems through the same
+// provider. Events that arrive out of order change nothing.
+func (e *eventCommandHandler) Handle(ctx context.Context, command email.EventCommand) (*email.Domain, error) {
+	ctx, span := e.tracer.Start(ctx, "app.email.event.command.handler", trace.WithAttributes(
+		attribute.String("operation", "event"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		e.log.Error("validation process failed",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "event"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	record, err := e.correlate(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		e.log.Error("failed to correlate event",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "event"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	if record == nil {
+		e.log.Info("event matches no email",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "event"),
+			zap.String("type", string(command.Type)),
+			zap.String("message_id", command.MessageId),
+			zap.String("provider_id", command.ProviderId),
+		)
+
+		return nil, nil
+	}
+
+	reason := strings.TrimSpace(command.Status + " " + command.Diagnostic)
+
+	// every recipient of a multi-recipient email reports on its own, so
+	// repeats of the current state are expected
+	next := command.Type.State()
+	if next != "" && record.State() != next {
+		from := record.State()
+		if !from.CanTransition(next) {
+			e.log.Info("event out of order ignored",
+				zap.String("trace_id", traceId),
+				zap.String("operation", "event"),
+				zap.String("id", record.ID()),
+				zap.String("type", string(command.Type)),
+				zap.String("state", string(from)),
+			)
+
+			return record, nil
+		}
+
+		var err error
+		if next == email.StateBounced {
+			err = record.Bounce(reason)
+		} else {
+			err = record.Transition(next)
+		}
+		if err != nil {
+			err := ose_error.Wrap(err, ose_error.ErrConflict, err.Error(), traceId)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			e.log.Error("failed to apply event",
+				zap.String("trace_id", traceId),
+				zap.String("operation", "event"),
+				zap.String("id", record.ID()),
+				zap.Error(err),
+			)
+
+			return nil, err
+		}
+
+		if err := e.repo.Email.UpdateFrom(ctx, *record, from); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			e.log.Error("failed to update email",
+				zap.String("trace_id", traceId),
+				zap.String("operation", "event"),
+				zap.Error(err),
+			)
+
+			return nil, err
+		}
+	}
+
+	if err := e.suppress(ctx, command, reason); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		e.log.Error("failed to suppress recipient",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "event"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	e.log.Info("event process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "event"),
+		zap.String("id", record.ID()),
+		zap.String("type", string(command.Type)),
+		zap.Bool("permanent", command.Permanent),
+	)
+	return record, nil
+}
+
+// correlate finds the email by Message-ID first and provider id second. Only a
+// lookup that found nothing means no match; any other failure is returned so
+// the event is retried instead of dropped.
+func (e *eventCommandHandler) correlate(ctx context.Context, command email.EventCommand) (*email.Domain, error) {
+	lookups := make([]dto.Request, 0, 2)
+	if command.MessageId != "" {
+		lookups = append(lookups, attemptRequest("attempts.message_id", messageId(command.MessageId)))
+	}
+	if command.ProviderId != "" {
+		lookups = append(lookups, attemptRequest("attempts.provider_id", command.ProviderId))
+	}
+
+	for _, request := range lookups {
+		record, err := e.repo.Email.ReadOne(ctx, request)
+		if err == nil {
+			return record, nil
+		}
+
+		if !isNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// suppress stops further mail to recipients that hard bounced or complained.
+func (e *eventCommandHandler) suppress(ctx context.Context, command email.EventCommand, note string) error {
+	if command.Recipient == "" {
+		return nil
+	}
+
+	var reason suppression.Reason
+	switch {
+	case command.Type == email.EventBounced && command.Permanent:
+		reason = suppression.ReasonBounce
+	case command.Type == email.EventComplained:
+		reason = suppression.ReasonComplaint
+	default:
+		return nil
+	}
+
+	_, err := e.suppressions.Add(ctx, suppression.AddCommand{
+		Address: command.Recipient,
+		Reason:  reason,
+		Source:  command.Source,
+		Note:    note,
+	})
+
+	return err
+}
+
+// messageId restores the angle brackets some providers strip from a Message-ID.
+func messageId(value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.HasPrefix(value, "<") {
+		return value
+	}
+
+	return "<" + value + ">"
+}
+
+// attemptRequest looks an email up by a field of any of its attempts.
+func attemptRequest(field, value string) dto.Request {
+	return dto.Request{
+		Queries: []dto.Query{
+			{
+				Name: "one",
+				Filters: []dto.Filter{
+					{
+						Field: field,
+						Op:    dto.OpEq,
+						Value: value,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newEventCommandHandler(repo repository.Repository, log logger.Logger, tracer tracing.Tracer,
+	suppressions suppression.App) cqrs.CommandHandle[email.EventCommand, *email.Domain] {
+	return &eventCommandHandler{
+		repo:         repo,
+		log:          log,
+		tracer:       tracer,
+		suppressions: suppressions,
+	}
+}
@@ -0,0 +1,81 @@
+package email
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/postman/internal/infrastructure/repository"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Handler
+type releaseCommandHandler struct {
+	repo   repository.Repository
+	log    logger.Logger
+	tracer tracing.Tracer
+}
+
+// Handle implements cqrs.CommandHandle.
+func (r *releaseCommandHandler) Handle(ctx context.Context, command email.ReleaseCommand) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "app.email.release.command.handler", trace.WithAttributes(
+		attribute.String("operation", "release"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("validation process failed",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "release"),
+			zap.Error(err),
+		)
+
+		return 0, err
+	}
+
+	released, err := r.repo.Email.Release(ctx, command.At)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to release scheduled emails",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "release"),
+			zap.Error(err),
+		)
+
+		return int64(len(released)), err
+	}
+
+	if len(released) > 0 {
+		r.log.Info("release process complete successfully",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "release"),
+			zap.Int("released", len(released)),
+		)
+	}
+
+	return int64(len(released)), nil
+}
+
+func newReleaseCommandHandler(repo repository.Repository, log logger.Logger,
+	tracer tracing.Tracer) cqrs.CommandHandle[email.ReleaseCommand, int64] {
+	return &releaseCommandHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+	}
+}
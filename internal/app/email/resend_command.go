@@ -10,7 +10,6 @@ import (
 	"github.com/ose-micro/core/tracing"
 	"github.com/ose-micro/cqrs"
 	ose_error "github.com/ose-micro/error"
-	"github.com/ose-micro/mailer"
 	"github.com/ose-micro/postman/internal/business"
 	"github.com/ose-micro/postman/internal/business/email"
 	"github.com/ose-micro/postman/internal/infrastructure/repository"
@@ -25,7 +24,6 @@ type resendCommandHandler struct {
 	repo   repository.Repository
 	log    logger.Logger
 	bus    domain.Bus
-	mailer *mailer.Mailer
 	tracer tracing.Tracer
 	bs     business.Domain
 }
@@ -39,7 +37,6 @@ func (u *resendCommandHandler) Handle(ctx context.Context, command email.IdComma
 	defer span.End()
 
 	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
-	var state email.State
 
 	// validate command payload
 	if err := command.Validate(); err != nil {
@@ -81,11 +78,13 @@ func (u *resendCommandHandler) Handle(ctx context.Context, command email.IdComma
 		return nil, err
 	}
 
-	if record.State() == email.StateComplete {
-		err = ose_error.New(ose_error.ErrNotFound, "email is already in a completed state", traceId)
+	// the dispatcher picks the record up again like any freshly created email
+	previous := record.State()
+	if err := record.Requeue(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrConflict, err.Error(), traceId)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		u.log.Error("failed to send mail",
+		u.log.Error("failed to requeue mail",
 			zap.String("trace_id", traceId),
 			zap.String("operation", "resend_mail"),
 			zap.Error(err),
@@ -94,33 +93,9 @@ func (u *resendCommandHandler) Handle(ctx context.Context, command email.IdComma
 		return nil, err
 	}
 
-	err = u.mailer.Send(ctx, mailer.Params{
-		Sender:    record.Sender(),
-		Recipient: record.Recipient(),
-		Subject:   record.Subject(),
-		Message:   record.Message(),
-		Data:      record.Data(),
-		From:      record.From(),
-	})
-	if err != nil {
-		err := ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-		u.log.Error("failed to resend mail",
-			zap.String("trace_id", traceId),
-			zap.String("operation", "resend_mail"),
-			zap.Error(err),
-		)
-
-		state = email.StateFailed
-	} else {
-		state = email.StateComplete
-	}
-	record.SetState(state)
-
-	// save email to write store
-	err = u.repo.Email.Update(ctx, *record)
-	if err != nil {
+	// a webhook, bounce or dispatcher may change the email between the read
+	// and this write; the save then conflicts instead of overwriting it
+	if err := u.repo.Email.UpdateFrom(ctx, *record, previous); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		u.log.Error("failed to update to postgres",
@@ -141,13 +116,12 @@ func (u *resendCommandHandler) Handle(ctx context.Context, command email.IdComma
 }
 
 func newResendCommandHandler(bs business.Domain, repo repository.Repository, log logger.Logger,
-	tracer tracing.Tracer, bus domain.Bus, mailer *mailer.Mailer) cqrs.CommandHandle[email.IdCommand, *email.Domain] {
+	tracer tracing.Tracer, bus domain.Bus) cqrs.CommandHandle[email.IdCommand, *email.Domain] {
 	return &resendCommandHandler{
 		repo:   repo,
 		log:    log,
 		tracer: tracer,
 		bus:    bus,
 		bs:     bs,
-		mailer: mailer,
 	}
 }
@@ -0,0 +1,53 @@
+package email
+
+import (
+	"context"
+
+	"github.com/ose-micro/postman/internal/business/suppression"
+)
+
+// suppressedRecipients returns the normalized addresses among the recipients that may not
+// receive an email of category.
+func suppressedRecipients(ctx context.Context, suppressions suppression.App, category string, lists ...[]string) (map[string]bool, error) {
+	addresses := make([]string, 0)
+	for _, list := range lists {
+		addresses = append(addresses, list...)
+	}
+
+	records, err := suppressions.Check(ctx, suppression.CheckQuery{
+		Addresses: addresses,
+		Category:  category,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	blocked := make(map[string]bool, len(records))
+	for _, record := range records {
+		blocked[record.Address()] = true
+	}
+
+	return blocked, nil
+}
+
+func unsuppressed(list []string, blocked map[string]bool) []string {
+	kept := make([]string, 0, len(list))
+	for _, address := range list {
+		if !blocked[suppression.Normalize(address)] {
+			kept = append(kept, address)
+		}
+	}
+
+	return kept
+}
+
+func suppressedOf(list []string, blocked map[string]bool) []string {
+	dropped := make([]string, 0)
+	for _, address := range list {
+		if blocked[suppression.Normalize(address)] {
+			dropped = append(dropped, address)
+		}
+	}
+
+	return dropped
+}
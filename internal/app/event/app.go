@@ -0,0 +1,55 @@
+package event
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/domain"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	"github.com/ose-micro/postman/internal/business/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type eventApp struct {
+	tracer tracing.Tracer
+	log    logger.Logger
+	relay  cqrs.CommandHandle[event.RelayCommand, *event.Message]
+}
+
+// Relay implements event.App.
+func (e *eventApp) Relay(ctx context.Context, command event.RelayCommand) (*event.Message, error) {
+	ctx, span := e.tracer.Start(ctx, "app.event.relay.command", trace.WithAttributes(
+		attribute.String("operation", "relay"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	message, err := e.relay.Handle(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		e.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "relay"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func NewEventApp(log logger.Logger, tracer tracing.Tracer, repo event.Outbox, bus domain.Bus) event.App {
+	return &eventApp{
+		tracer: tracer,
+		log:    log,
+		relay:  newRelayCommandHandler(repo, log, tracer, bus),
+	}
+}
@@ -0,0 +1,126 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ose-micro/core/domain"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Handler
+type relayCommandHandler struct {
+	repo   event.Outbox
+	log    logger.Logger
+	tracer tracing.Tracer
+	bus    domain.Bus
+}
+
+// Handle implements cqrs.CommandHandle.
+func (r *relayCommandHandler) Handle(ctx context.Context, command event.RelayCommand) (*event.Message, error) {
+	ctx, span := r.tracer.Start(ctx, "app.event.relay.command.handler", trace.WithAttributes(
+		attribute.String("operation", "relay"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("validation process failed",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "relay"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	message, err := r.repo.Claim(ctx, command.Lease)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to claim outbox message",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "relay"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	if message == nil {
+		return nil, nil
+	}
+
+	// the payload is already the encoded envelope, so it goes out byte for byte
+	if err := r.bus.Publish(message.Subject, json.RawMessage(message.Payload)); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to publish outbox message",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "relay"),
+			zap.String("id", message.Id),
+			zap.String("subject", message.Subject),
+			zap.Int32("attempts", message.Attempts),
+			zap.Error(err),
+		)
+
+		// the reason is only kept for inspection; the lease alone schedules the retry
+		if failed := r.repo.Failed(ctx, message.Id, err.Error()); failed != nil {
+			r.log.Error("failed to record outbox failure",
+				zap.String("trace_id", traceId),
+				zap.String("operation", "relay"),
+				zap.String("id", message.Id),
+				zap.Error(failed),
+			)
+		}
+
+		return nil, err
+	}
+
+	// a crash before this point publishes the message again once the lease runs out
+	if err := r.repo.Dispatched(ctx, message.Id); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to mark outbox message dispatched",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "relay"),
+			zap.String("id", message.Id),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	r.log.Info("relay process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "relay"),
+		zap.String("id", message.Id),
+		zap.String("subject", message.Subject),
+	)
+	return message, nil
+}
+
+func newRelayCommandHandler(repo event.Outbox, log logger.Logger, tracer tracing.Tracer,
+	bus domain.Bus) cqrs.CommandHandle[event.RelayCommand, *event.Message] {
+	return &relayCommandHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+		bus:    bus,
+	}
+}
@@ -0,0 +1,223 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	"github.com/ose-micro/postman/internal/business"
+	"github.com/ose-micro/postman/internal/business/subscription"
+	"github.com/ose-micro/postman/internal/infrastructure/webhook"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type subscriptionApp struct {
+	tracer     tracing.Tracer
+	log        logger.Logger
+	create     cqrs.CommandHandle[subscription.CreateCommand, *subscription.Domain]
+	delete     cqrs.CommandHandle[subscription.IdCommand, bool]
+	read       cqrs.QueryHandle[subscription.ReadQuery, map[string]any]
+	notify     cqrs.CommandHandle[subscription.NotifyCommand, *subscription.Notification]
+	deliver    cqrs.CommandHandle[subscription.DeliverCommand, *subscription.Delivery]
+	deliveries cqrs.QueryHandle[subscription.DeliveriesQuery, map[string]any]
+	replay     cqrs.CommandHandle[subscription.ReplayCommand, int64]
+}
+
+// Create implements subscription.App.
+func (s *subscriptionApp) Create(ctx context.Context, command subscription.CreateCommand) (*subscription.Domain, error) {
+	ctx, span := s.tracer.Start(ctx, "app.subscription.create.command", trace.WithAttributes(
+		attribute.String("operation", "create"),
+		attribute.String("url", command.Url),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := s.create.Handle(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "create"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Delete implements subscription.App.
+func (s *subscriptionApp) Delete(ctx context.Context, command subscription.IdCommand) error {
+	ctx, span := s.tracer.Start(ctx, "app.subscription.delete.command", trace.WithAttributes(
+		attribute.String("operation", "delete"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	if _, err := s.delete.Handle(ctx, command); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "delete"),
+			zap.Error(err),
+		)
+
+		return err
+	}
+
+	return nil
+}
+
+// Read implements subscription.App.
+func (s *subscriptionApp) Read(ctx context.Context, request dto.Request) (map[string]any, error) {
+	ctx, span := s.tracer.Start(ctx, "app.subscription.read.query", trace.WithAttributes(
+		attribute.String("operation", "read"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := s.read.Handle(ctx, subscription.ReadQuery{
+		Request: request,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to process query",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Notify implements subscription.App.
+func (s *subscriptionApp) Notify(ctx context.Context, command subscription.NotifyCommand) (*subscription.Notification, error) {
+	ctx, span := s.tracer.Start(ctx, "app.subscription.notify.command", trace.WithAttributes(
+		attribute.String("operation", "notify"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := s.notify.Handle(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "notify"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Deliver implements subscription.App.
+func (s *subscriptionApp) Deliver(ctx context.Context, command subscription.DeliverCommand) (*subscription.Delivery, error) {
+	ctx, span := s.tracer.Start(ctx, "app.subscription.deliver.command", trace.WithAttributes(
+		attribute.String("operation", "deliver"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := s.deliver.Handle(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "deliver"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Deliveries implements subscription.App.
+func (s *subscriptionApp) Deliveries(ctx context.Context, request dto.Request) (map[string]any, error) {
+	ctx, span := s.tracer.Start(ctx, "app.subscription.deliveries.query", trace.WithAttributes(
+		attribute.String("operation", "deliveries"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := s.deliveries.Handle(ctx, subscription.DeliveriesQuery{
+		Request: request,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to process query",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "deliveries"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Replay implements subscription.App.
+func (s *subscriptionApp) Replay(ctx context.Context, command subscription.ReplayCommand) (int64, error) {
+	ctx, span := s.tracer.Start(ctx, "app.subscription.replay.command", trace.WithAttributes(
+		attribute.String("operation", "replay"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := s.replay.Handle(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "replay"),
+			zap.Error(err),
+		)
+
+		return 0, err
+	}
+
+	return record, nil
+}
+
+func NewSubscriptionApp(bs business.Domain, log logger.Logger, tracer tracing.Tracer,
+	repo subscription.Repo) subscription.App {
+	return &subscriptionApp{
+		tracer:     tracer,
+		log:        log,
+		create:     newCreateCommandHandler(bs, repo, log, tracer),
+		delete:     newDeleteCommandHandler(repo, log, tracer),
+		read:       newReadQueryHandler(repo, log, tracer),
+		notify:     newNotifyCommandHandler(repo, log, tracer),
+		deliver:    newDeliverCommandHandler(repo, log, tracer, webhook.NewSender()),
+		deliveries: newDeliveriesQueryHandler(repo, log, tracer),
+		replay:     newReplayCommandHandler(repo, log, tracer),
+	}
+}
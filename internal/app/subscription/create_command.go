@@ -0,0 +1,109 @@
+package subscription
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business"
+	"github.com/ose-micro/postman/internal/business/subscription"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Handler
+type createCommandHandler struct {
+	repo   subscription.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+	bs     business.Domain
+}
+
+// Handle implements cqrs.CommandHandle.
+func (c *createCommandHandler) Handle(ctx context.Context, command subscription.CreateCommand) (*subscription.Domain, error) {
+	ctx, span := c.tracer.Start(ctx, "app.subscription.create.command.handler", trace.WithAttributes(
+		attribute.String("operation", "create"),
+		attribute.String("url", command.Url),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.log.Error("validation process failed",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "create"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	secret := command.Secret
+	if secret == "" {
+		random := make([]byte, 32)
+		if _, err := rand.Read(random); err != nil {
+			return nil, ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		}
+
+		secret = hex.EncodeToString(random)
+	}
+
+	record, err := c.bs.Subscription.New(subscription.Params{
+		Url:    command.Url,
+		Secret: secret,
+		Filter: command.Filter,
+		Tenant: command.Tenant,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.log.Error("failed to create business",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "create"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	if err := c.repo.Create(ctx, *record); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.log.Error("fail while saving business",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "create"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	c.log.Info("create process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "create"),
+		zap.String("id", record.ID()),
+		zap.String("url", command.Url),
+	)
+	return record, nil
+}
+
+func newCreateCommandHandler(bs business.Domain, repo subscription.Repo, log logger.Logger,
+	tracer tracing.Tracer) cqrs.CommandHandle[subscription.CreateCommand, *subscription.Domain] {
+	return &createCommandHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+		bs:     bs,
+	}
+}
@@ -0,0 +1,109 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/subscription"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Handler
+type deleteCommandHandler struct {
+	repo   subscription.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+}
+
+// Handle implements cqrs.CommandHandle. Pending deliveries of the subscription
+// fail when they come due.
+func (d *deleteCommandHandler) Handle(ctx context.Context, command subscription.IdCommand) (bool, error) {
+	ctx, span := d.tracer.Start(ctx, "app.subscription.delete.command.handler", trace.WithAttributes(
+		attribute.String("operation", "delete"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("validation process failed",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "delete"),
+			zap.Error(err),
+		)
+
+		return false, err
+	}
+
+	record, err := d.repo.ReadOne(ctx, idRequest(command.Id))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to repository subscription",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "delete"),
+			zap.Error(err),
+		)
+
+		return false, err
+	}
+
+	if err := d.repo.Delete(ctx, *record); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to delete subscription",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "delete"),
+			zap.Error(err),
+		)
+
+		return false, err
+	}
+
+	d.log.Info("delete process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "delete"),
+		zap.Any("payload", command),
+	)
+	return true, nil
+}
+
+// idRequest looks a subscription up by id.
+func idRequest(id string) dto.Request {
+	return dto.Request{
+		Queries: []dto.Query{
+			{
+				Name: "one",
+				Filters: []dto.Filter{
+					{
+						Field: "_id",
+						Op:    dto.OpEq,
+						Value: id,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newDeleteCommandHandler(repo subscription.Repo, log logger.Logger,
+	tracer tracing.Tracer) cqrs.CommandHandle[subscription.IdCommand, bool] {
+	return &deleteCommandHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+	}
+}
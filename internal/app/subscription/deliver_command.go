@@ -0,0 +1,162 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/subscription"
+	"github.com/ose-micro/postman/internal/infrastructure/webhook"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Handler
+type deliverCommandHandler struct {
+	repo   subscription.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+	sender *webhook.Sender
+}
+
+// Handle implements cqrs.CommandHandle.
+func (d *deliverCommandHandler) Handle(ctx context.Context, command subscription.DeliverCommand) (*subscription.Delivery, error) {
+	ctx, span := d.tracer.Start(ctx, "app.subscription.deliver.command.handler", trace.WithAttributes(
+		attribute.String("operation", "deliver"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("validation process failed",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "deliver"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	delivery, err := d.repo.ClaimDelivery(ctx, command.Lease)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to claim delivery",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "deliver"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	if delivery == nil {
+		return nil, nil
+	}
+
+	// the secret is read at send time so a rotated secret applies to retries
+	record, err := d.repo.ReadOne(ctx, idRequest(delivery.SubscriptionId))
+	if isNotFound(err) {
+		delivery.Failed(0, "subscription deleted", nil)
+		return d.save(ctx, delivery)
+	}
+	if err != nil {
+		// the claim runs out and the delivery is picked up again
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		d.log.Error("failed to read subscription",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "deliver"),
+			zap.String("id", delivery.Id),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	postCtx, cancel := context.WithTimeout(ctx, command.Timeout)
+	status, err := d.sender.Post(postCtx, webhook.Callback{
+		Url:    record.Url(),
+		Secret: record.Secret(),
+		Id:     delivery.Id,
+		Event:  delivery.Event,
+		Body:   []byte(delivery.Payload),
+	})
+	cancel()
+
+	var rejected *webhook.StatusError
+	switch {
+	case err == nil:
+		delivery.Succeeded(int32(status))
+	case errors.As(err, &rejected) && !rejected.Retryable(),
+		command.Retry.Exhausted(delivery.Attempts + 1):
+		delivery.Failed(int32(status), err.Error(), nil)
+	default:
+		retryAt := time.Now().Add(command.Retry.Backoff(delivery.Attempts + 1))
+		delivery.Failed(int32(status), err.Error(), &retryAt)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		d.log.Error("failed to post callback",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "deliver"),
+			zap.String("id", delivery.Id),
+			zap.String("state", string(delivery.State)),
+			zap.Error(err),
+		)
+	}
+
+	return d.save(ctx, delivery)
+}
+
+func (d *deliverCommandHandler) save(ctx context.Context, delivery *subscription.Delivery) (*subscription.Delivery, error) {
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	if err := d.repo.UpdateDelivery(ctx, *delivery); err != nil {
+		d.log.Error("failed to update delivery",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "deliver"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	d.log.Info("deliver process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "deliver"),
+		zap.String("id", delivery.Id),
+		zap.String("state", string(delivery.State)),
+		zap.Int32("attempts", delivery.Attempts),
+	)
+	return delivery, nil
+}
+
+func isNotFound(err error) bool {
+	var failure *ose_error.Error
+	return errors.As(err, &failure) && failure.Code == ose_error.ErrNotFound
+}
+
+func newDeliverCommandHandler(repo subscription.Repo, log logger.Logger, tracer tracing.Tracer,
+	sender *webhook.Sender) cqrs.CommandHandle[subscription.DeliverCommand, *subscription.Delivery] {
+	return &deliverCommandHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+		sender: sender,
+	}
+}
@@ -0,0 +1,61 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	"github.com/ose-micro/postman/internal/business/subscription"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type deliveriesQueryHandler struct {
+	repo   subscription.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+}
+
+// Handle implements cqrs.QueryHandle.
+func (r *deliveriesQueryHandler) Handle(ctx context.Context, query subscription.DeliveriesQuery) (map[string]any, error) {
+	ctx, span := r.tracer.Start(ctx, "app.subscription.deliveries.query.handler", trace.WithAttributes(
+		attribute.String("operation", "deliveries"),
+		attribute.String("payload", fmt.Sprintf("%v", query)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	records, err := r.repo.ReadDeliveries(ctx, query.Request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to repository deliveries",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "deliveries"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	r.log.Info("repository process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "deliveries"),
+		zap.Any("payload", fmt.Sprintf("%v", query)),
+	)
+	return records, nil
+}
+
+func newDeliveriesQueryHandler(repo subscription.Repo, log logger.Logger,
+	tracer tracing.Tracer) cqrs.QueryHandle[subscription.DeliveriesQuery, map[string]any] {
+	return &deliveriesQueryHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+	}
+}
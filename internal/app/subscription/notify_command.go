@@ -0,0 +1,136 @@
+package subscription
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/subscription"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Handler
+type notifyCommandHandler struct {
+	repo   subscription.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+}
+
+// Handle implements cqrs.CommandHandle. It only queues deliveries; the
+// notifier posts them.
+func (n *notifyCommandHandler) Handle(ctx context.Context, command subscription.NotifyCommand) (*subscription.Notification, error) {
+	ctx, span := n.tracer.Start(ctx, "app.subscription.notify.command.handler", trace.WithAttributes(
+		attribute.String("operation", "notify"),
+		attribute.String("lease", command.Lease.String()),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		n.log.Error("validation process failed",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "notify"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	notification, err := n.repo.ClaimNotification(ctx, command.Lease)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		n.log.Error("failed to claim notification",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "notify"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	if notification == nil {
+		return nil, nil
+	}
+
+	span.SetAttributes(
+		attribute.String("email_id", notification.Email.Id),
+		attribute.Int("changes", len(notification.Changes)),
+	)
+
+	tenant := notification.Email.Tags[subscription.TenantTag]
+	deliveries := make([]subscription.Delivery, 0)
+	for _, change := range notification.Changes {
+		event := subscription.EventName(change.To)
+		subscriptions, err := n.repo.Match(ctx, event, tenant)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			n.log.Error("failed to match subscriptions",
+				zap.String("trace_id", traceId),
+				zap.String("operation", "notify"),
+				zap.Error(err),
+			)
+
+			return nil, err
+		}
+
+		for _, record := range subscriptions {
+			if !record.Wants(event, tenant) {
+				continue
+			}
+
+			delivery := subscription.NewDelivery(record.ID(), notification.Email.Id, event)
+			payload, err := json.Marshal(subscription.NewPayload(delivery.Id, notification.Email, change))
+			if err != nil {
+				return nil, ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+			}
+
+			delivery.Payload = string(payload)
+			deliveries = append(deliveries, delivery)
+		}
+	}
+
+	if err := n.repo.Fanout(ctx, notification.Id, deliveries); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		n.log.Error("failed to queue deliveries",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "notify"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	if len(deliveries) > 0 {
+		n.log.Info("notify process complete successfully",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "notify"),
+			zap.String("email_id", notification.Email.Id),
+			zap.Int("deliveries", len(deliveries)),
+		)
+	}
+
+	return notification, nil
+}
+
+func newNotifyCommandHandler(repo subscription.Repo, log logger.Logger,
+	tracer tracing.Tracer) cqrs.CommandHandle[subscription.NotifyCommand, *subscription.Notification] {
+	return &notifyCommandHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+	}
+}
@@ -0,0 +1,86 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/subscription"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Handler
+type replayCommandHandler struct {
+	repo   subscription.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+}
+
+// Handle implements cqrs.CommandHandle. Replayed callbacks keep their delivery
+// id and original payload.
+func (r *replayCommandHandler) Handle(ctx context.Context, command subscription.ReplayCommand) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "app.subscription.replay.command.handler", trace.WithAttributes(
+		attribute.String("operation", "replay"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("validation process failed",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "replay"),
+			zap.Error(err),
+		)
+
+		return 0, err
+	}
+
+	replayed, err := r.repo.Replay(ctx, command.Id, command.SubscriptionId)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to replay deliveries",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "replay"),
+			zap.Error(err),
+		)
+
+		return 0, err
+	}
+
+	if command.Id != "" && replayed == 0 {
+		err := ose_error.New(ose_error.ErrNotFound, "delivery not found", traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return 0, err
+	}
+
+	r.log.Info("replay process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "replay"),
+		zap.Int64("replayed", replayed),
+	)
+	return replayed, nil
+}
+
+func newReplayCommandHandler(repo subscription.Repo, log logger.Logger,
+	tracer tracing.Tracer) cqrs.CommandHandle[subscription.ReplayCommand, int64] {
+	return &replayCommandHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+	}
+}
@@ -0,0 +1,146 @@
+package suppression
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business"
+	"github.com/ose-micro/postman/internal/business/suppression"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Handler
+type addCommandHandler struct {
+	repo   suppression.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+	bs     business.Domain
+}
+
+// Handle implements cqrs.CommandHandle.
+func (a *addCommandHandler) Handle(ctx context.Context, command suppression.AddCommand) (*suppression.Domain, error) {
+	ctx, span := a.tracer.Start(ctx, "app.suppression.add.command.handler", trace.WithAttributes(
+		attribute.String("operation", "add"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		a.log.Error("validation process failed",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "add"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	source := command.Source
+	if source == "" {
+		source = "api"
+	}
+
+	// suppressing an address again refreshes the existing entry
+	if record, _ := a.repo.ReadOne(ctx, addressRequest(command.Address, command.Category)); record != nil {
+		record.Refresh(command.Reason, source, command.Note, command.ExpiresAt)
+		if err := a.repo.Update(ctx, *record); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			a.log.Error("failed to update suppression",
+				zap.String("trace_id", traceId),
+				zap.String("operation", "add"),
+				zap.Error(err),
+			)
+
+			return nil, err
+		}
+
+		return record, nil
+	}
+
+	record, err := a.bs.Suppression.New(suppression.Params{
+		Address:   command.Address,
+		Reason:    command.Reason,
+		Source:    source,
+		Category:  command.Category,
+		Note:      command.Note,
+		ExpiresAt: command.ExpiresAt,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		a.log.Error("failed to create business",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "add"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	if err := a.repo.Create(ctx, *record); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		a.log.Error("fail while saving business",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "add"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	a.log.Info("add process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "add"),
+		zap.Any("payload", command),
+	)
+	return record, nil
+}
+
+// addressRequest looks an entry up by its address and category.
+func addressRequest(address, category string) dto.Request {
+	return dto.Request{
+		Queries: []dto.Query{
+			{
+				Name: "one",
+				Filters: []dto.Filter{
+					{
+						Field: "address",
+						Op:    dto.OpEq,
+						Value: suppression.Normalize(address),
+					},
+					{
+						Field: "category",
+						Op:    dto.OpEq,
+						Value: category,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newAddCommandHandler(bs business.Domain, repo suppression.Repo, log logger.Logger,
+	tracer tracing.Tracer) cqrs.CommandHandle[suppression.AddCommand, *suppression.Domain] {
+	return &addCommandHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+		bs:     bs,
+	}
+}
@@ -0,0 +1,139 @@
+package suppression
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	"github.com/ose-micro/postman/internal/business"
+	"github.com/ose-micro/postman/internal/business/suppression"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type suppressionApp struct {
+	tracer tracing.Tracer
+	log    logger.Logger
+	add    cqrs.CommandHandle[suppression.AddCommand, *suppression.Domain]
+	remove cqrs.CommandHandle[suppression.RemoveCommand, bool]
+	read   cqrs.QueryHandle[suppression.ReadQuery, map[string]any]
+	check  cqrs.QueryHandle[suppression.CheckQuery, []suppression.Domain]
+}
+
+// Add implements suppression.App.
+func (s *suppressionApp) Add(ctx context.Context, command suppression.AddCommand) (*suppression.Domain, error) {
+	ctx, span := s.tracer.Start(ctx, "app.suppression.add.command", trace.WithAttributes(
+		attribute.String("operation", "add"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := s.add.Handle(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "add"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Remove implements suppression.App.
+func (s *suppressionApp) Remove(ctx context.Context, command suppression.RemoveCommand) error {
+	ctx, span := s.tracer.Start(ctx, "app.suppression.remove.command", trace.WithAttributes(
+		attribute.String("operation", "remove"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	if _, err := s.remove.Handle(ctx, command); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "remove"),
+			zap.Error(err),
+		)
+
+		return err
+	}
+
+	return nil
+}
+
+// Read implements suppression.App.
+func (s *suppressionApp) Read(ctx context.Context, request dto.Request) (map[string]any, error) {
+	ctx, span := s.tracer.Start(ctx, "app.suppression.read.query", trace.WithAttributes(
+		attribute.String("operation", "read"),
+		attribute.String("payload", fmt.Sprintf("%v", request)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	records, err := s.read.Handle(ctx, suppression.ReadQuery{
+		Request: request,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to process query",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// Check implements suppression.App.
+func (s *suppressionApp) Check(ctx context.Context, query suppression.CheckQuery) ([]suppression.Domain, error) {
+	ctx, span := s.tracer.Start(ctx, "app.suppression.check.query", trace.WithAttributes(
+		attribute.String("operation", "check"),
+		attribute.String("payload", fmt.Sprintf("%v", query)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	records, err := s.check.Handle(ctx, query)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to process query",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "check"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func NewSuppressionApp(bs business.Domain, log logger.Logger, tracer tracing.Tracer,
+	repo suppression.Repo) suppression.App {
+	return &suppressionApp{
+		tracer: tracer,
+		log:    log,
+		add:    newAddCommandHandler(bs, repo, log, tracer),
+		remove: newRemoveCommandHandler(repo, log, tracer),
+		read:   newReadQueryHandler(repo, log, tracer),
+		check:  newCheckQueryHandler(repo, log, tracer),
+	}
+}
@@ -0,0 +1,62 @@
+package suppression
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	"github.com/ose-micro/postman/internal/business/suppression"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type checkQueryHandler struct {
+	repo   suppression.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+}
+
+// Handle implements cqrs.QueryHandle.
+func (c *checkQueryHandler) Handle(ctx context.Context, query suppression.CheckQuery) ([]suppression.Domain, error) {
+	ctx, span := c.tracer.Start(ctx, "app.suppression.check.query.handler", trace.WithAttributes(
+		attribute.String("operation", "check"),
+		attribute.String("payload", fmt.Sprintf("%v", query)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	addresses := make([]string, 0, len(query.Addresses))
+	for _, address := range query.Addresses {
+		addresses = append(addresses, suppression.Normalize(address))
+	}
+
+	records, err := c.repo.Check(ctx, addresses, query.Category, time.Now())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.log.Error("failed to check suppressions",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "check"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func newCheckQueryHandler(repo suppression.Repo, log logger.Logger,
+	tracer tracing.Tracer) cqrs.QueryHandle[suppression.CheckQuery, []suppression.Domain] {
+	return &checkQueryHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+	}
+}
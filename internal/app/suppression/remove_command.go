@@ -0,0 +1,109 @@
+package suppression
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/suppression"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Handler
+type removeCommandHandler struct {
+	repo   suppression.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+}
+
+// Handle implements cqrs.CommandHandle.
+func (r *removeCommandHandler) Handle(ctx context.Context, command suppression.RemoveCommand) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "app.suppression.remove.command.handler", trace.WithAttributes(
+		attribute.String("operation", "remove"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("validation process failed",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "remove"),
+			zap.Error(err),
+		)
+
+		return false, err
+	}
+
+	request := addressRequest(command.Address, command.Category)
+	if command.Id != "" {
+		request = dto.Request{
+			Queries: []dto.Query{
+				{
+					Name: "one",
+					Filters: []dto.Filter{
+						{
+							Field: "_id",
+							Op:    dto.OpEq,
+							Value: command.Id,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	record, err := r.repo.ReadOne(ctx, request)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to repository suppression",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "remove"),
+			zap.Error(err),
+		)
+
+		return false, err
+	}
+
+	if err := r.repo.Delete(ctx, *record); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to delete suppression",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "remove"),
+			zap.Error(err),
+		)
+
+		return false, err
+	}
+
+	r.log.Info("remove process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "remove"),
+		zap.Any("payload", command),
+	)
+
+	return true, nil
+}
+
+func newRemoveCommandHandler(repo suppression.Repo, log logger.Logger,
+	tracer tracing.Tracer) cqrs.CommandHandle[suppression.RemoveCommand, bool] {
+	return &removeCommandHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+	}
+}
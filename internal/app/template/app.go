@@ -19,12 +19,16 @@ import (
 )
 
 type templateApp struct {
-	tracer tracing.Tracer
-	log    logger.Logger
-	create cqrs.CommandHandle[template.CreateCommand, *template.Domain]
-	update cqrs.CommandHandle[template.UpdateCommand, bool]
-	delete cqrs.CommandHandle[template.DeleteCommand, bool]
-	read   cqrs.QueryHandle[template.ReadQuery, map[string]any]
+	tracer    tracing.Tracer
+	log       logger.Logger
+	create    cqrs.CommandHandle[template.CreateCommand, *template.Domain]
+	update    cqrs.CommandHandle[template.UpdateCommand, bool]
+	delete    cqrs.CommandHandle[template.DeleteCommand, bool]
+	read      cqrs.QueryHandle[template.ReadQuery, map[string]any]
+	rollback  cqrs.CommandHandle[template.RollbackCommand, *template.Domain]
+	revision  cqrs.QueryHandle[template.RevisionQuery, *template.Revision]
+	revisions cqrs.QueryHandle[template.RevisionsQuery, []template.Revision]
+	render    cqrs.QueryHandle[template.RenderQuery, *template.Rendered]
 }
 
 // Create implements template.App.
@@ -128,14 +132,118 @@ func (t *templateApp) Update(ctx context.Context, command template.UpdateCommand
 	return nil
 }
 
+// Rollback implements template.App.
+func (t *templateApp) Rollback(ctx context.Context, command template.RollbackCommand) (*template.Domain, error) {
+	ctx, span := t.tracer.Start(ctx, "app.template.rollback.command", trace.WithAttributes(
+		attribute.String("operation", "rollback"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := t.rollback.Handle(ctx, command)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		t.log.Error("failed to process command",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "rollback"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Revision implements template.App.
+func (t *templateApp) Revision(ctx context.Context, query template.RevisionQuery) (*template.Revision, error) {
+	ctx, span := t.tracer.Start(ctx, "app.template.revision.query", trace.WithAttributes(
+		attribute.String("operation", "revision"),
+		attribute.String("payload", fmt.Sprintf("%v", query)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := t.revision.Handle(ctx, query)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		t.log.Error("failed to process query",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "revision"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// Revisions implements template.App.
+func (t *templateApp) Revisions(ctx context.Context, query template.RevisionsQuery) ([]template.Revision, error) {
+	ctx, span := t.tracer.Start(ctx, "app.template.revisions.query", trace.WithAttributes(
+		attribute.String("operation", "revisions"),
+		attribute.String("payload", fmt.Sprintf("%v", query)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	records, err := t.revisions.Handle(ctx, query)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		t.log.Error("failed to process query",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "revisions"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// Render implements template.App.
+func (t *templateApp) Render(ctx context.Context, query template.RenderQuery) (*template.Rendered, error) {
+	ctx, span := t.tracer.Start(ctx, "app.template.render.query", trace.WithAttributes(
+		attribute.String("operation", "render"),
+		attribute.String("payload", fmt.Sprintf("%v", query)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+	record, err := t.render.Handle(ctx, query)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		t.log.Error("failed to process query",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "render"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
 func NewTemplateApp(bs business.Domain, log logger.Logger, tracer tracing.Tracer,
 	repo template.Repo, bus domain.Bus, mailer *mailer.Mailer) template.App {
 	return &templateApp{
-		tracer: tracer,
-		log:    log,
-		create: newCreateCommandHandler(bs, repo, log, tracer,  mailer),
-		read:   newReadQueryHandler(repo, log, tracer),
-		update: newUpdateCommandHandler(bs, repo, log, tracer),
-		delete: newDeleteCommandHandler(bs, repo, log, tracer),
+		tracer:    tracer,
+		log:       log,
+		create:    newCreateCommandHandler(bs, repo, log, tracer, mailer),
+		read:      newReadQueryHandler(repo, log, tracer),
+		update:    newUpdateCommandHandler(bs, repo, log, tracer, mailer),
+		delete:    newDeleteCommandHandler(bs, repo, log, tracer),
+		rollback:  newRollbackCommandHandler(bs, repo, log, tracer),
+		revision:  newRevisionQueryHandler(repo, log, tracer),
+		revisions: newRevisionsQueryHandler(repo, log, tracer),
+		render:    newRenderQueryHandler(repo, log, tracer, mailer),
 	}
 }
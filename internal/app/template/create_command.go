@@ -3,6 +3,7 @@ package template
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/ose-micro/core/logger"
 	"github.com/ose-micro/core/tracing"
@@ -50,7 +51,8 @@ func (c *createCommandHandler) Handle(ctx context.Context, command template.Crea
 		return nil, err
 	}
 
-	if err := c.mailer.ValidateData(command.Content, command.Placeholders); err != nil {
+	// the subject is rendered with the same data as the body, so both must only use declared placeholders
+	if err := c.mailer.ValidateData(strings.Join([]string{command.Subject, command.HtmlContent, command.TextContent}, "\n"), command.Placeholders); err != nil {
 		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -63,9 +65,26 @@ func (c *createCommandHandler) Handle(ctx context.Context, command template.Crea
 		return nil, err
 	}
 
+	if command.Key != "" {
+		if check, _ := c.repo.ReadOne(ctx, template.ByField("key", command.Key)); check != nil {
+			err := ose_error.New(ose_error.ErrConflict, "template already exist with this key", traceId)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			c.log.Error("validation process fail",
+				zap.String("trace_id", traceId),
+				zap.String("operation", "create"),
+				zap.Any("details", err),
+			)
+
+			return nil, err
+		}
+	}
+
 	// create template
 	domain, err := c.bs.Template.New(template.Params{
-		Content:      command.Content,
+		Key:          command.Key,
+		HtmlContent:  command.HtmlContent,
+		TextContent:  command.TextContent,
 		Subject:      command.Subject,
 		Placeholders: command.Placeholders,
 	})
@@ -0,0 +1,145 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/mailer"
+	"github.com/ose-micro/postman/internal/business/template"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type renderQueryHandler struct {
+	repo   template.Repo
+	log    logger.Logger
+	mailer *mailer.Mailer
+	tracer tracing.Tracer
+}
+
+// Handle implements cqrs.QueryHandle.
+func (r *renderQueryHandler) Handle(ctx context.Context, query template.RenderQuery) (*template.Rendered, error) {
+	ctx, span := r.tracer.Start(ctx, "app.template.render.query.handler", trace.WithAttributes(
+		attribute.String("operation", "render"),
+		attribute.String("payload", fmt.Sprintf("%v", query)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	if err := query.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("validation process fail",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "render"),
+			zap.Any("details", err),
+		)
+
+		return nil, err
+	}
+
+	content, text, subject := query.HtmlContent, query.TextContent, query.Subject
+	if query.Template != "" {
+		record, err := template.Lookup(ctx, r.repo, query.Template)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			r.log.Error("failed to repository template",
+				zap.String("trace_id", traceId),
+				zap.String("operation", "render"),
+				zap.Error(err),
+			)
+
+			return nil, err
+		}
+
+		content, text, subject = record.HtmlContent(), record.TextContent(), record.Subject()
+	}
+
+	data := query.Data
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
+	// a preview is still useful with gaps, so missing keys are reported instead of rejected
+	missing, unused := template.Diff(template.Placeholders(content, text, subject), data)
+
+	html, err := r.mailer.Rerendered(content, data)
+	if err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to render content",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "render"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	renderedSubject, err := r.mailer.Rerendered(subject, data)
+	if err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to render subject",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "render"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	// without an authored text body the alternative is derived from the rendered html
+	plain := template.PlainText(html)
+	if text != "" {
+		plain, err = r.mailer.Rerendered(text, data)
+		if err != nil {
+			err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			r.log.Error("failed to render text",
+				zap.String("trace_id", traceId),
+				zap.String("operation", "render"),
+				zap.Error(err),
+			)
+
+			return nil, err
+		}
+	}
+
+	r.log.Info("render process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "render"),
+		zap.Strings("missing", missing),
+		zap.Strings("unused", unused),
+	)
+
+	return &template.Rendered{
+		Subject: renderedSubject,
+		Html:    html,
+		Text:    plain,
+		Missing: missing,
+		Unused:  unused,
+	}, nil
+}
+
+func newRenderQueryHandler(repo template.Repo, log logger.Logger, tracer tracing.Tracer,
+	mailer *mailer.Mailer) cqrs.QueryHandle[template.RenderQuery, *template.Rendered] {
+	return &renderQueryHandler{
+		repo:   repo,
+		log:    log,
+		mailer: mailer,
+		tracer: tracer,
+	}
+}
@@ -0,0 +1,93 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/template"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type revisionQueryHandler struct {
+	repo   template.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+}
+
+// Handle implements cqrs.QueryHandle.
+func (r *revisionQueryHandler) Handle(ctx context.Context, query template.RevisionQuery) (*template.Revision, error) {
+	ctx, span := r.tracer.Start(ctx, "app.template.revision.query.handler", trace.WithAttributes(
+		attribute.String("operation", "revision"),
+		attribute.String("payload", fmt.Sprintf("%v", query)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	if err := query.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("validation process fail",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "revision"),
+			zap.Any("details", err),
+		)
+
+		return nil, err
+	}
+
+	record, err := r.repo.ReadOneRevision(ctx, revisionRequest(query.Id, query.Version))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to repository template revision",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "revision"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func revisionRequest(id string, version int32) dto.Request {
+	return dto.Request{
+		Queries: []dto.Query{
+			{
+				Name: "one",
+				Filters: []dto.Filter{
+					{
+						Field: "template",
+						Op:    dto.OpEq,
+						Value: id,
+					},
+					{
+						Field: "version",
+						Op:    dto.OpEq,
+						Value: version,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newRevisionQueryHandler(repo template.Repo, log logger.Logger,
+	tracer tracing.Tracer) cqrs.QueryHandle[template.RevisionQuery, *template.Revision] {
+	return &revisionQueryHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+	}
+}
@@ -0,0 +1,99 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business/template"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type revisionsQueryHandler struct {
+	repo   template.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+}
+
+// Handle implements cqrs.QueryHandle.
+func (r *revisionsQueryHandler) Handle(ctx context.Context, query template.RevisionsQuery) ([]template.Revision, error) {
+	ctx, span := r.tracer.Start(ctx, "app.template.revisions.query.handler", trace.WithAttributes(
+		attribute.String("operation", "revisions"),
+		attribute.String("payload", fmt.Sprintf("%v", query)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	if err := query.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("validation process fail",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "revisions"),
+			zap.Any("details", err),
+		)
+
+		return nil, err
+	}
+
+	records, err := r.repo.ReadRevisions(ctx, dto.Request{
+		Queries: []dto.Query{
+			{
+				Name: "revisions",
+				Filters: []dto.Filter{
+					{
+						Field: "template",
+						Op:    dto.OpEq,
+						Value: query.Id,
+					},
+				},
+				Sort: []dto.SortOption{
+					{
+						Field: "version",
+						Order: dto.SortDesc,
+					},
+				},
+				Skip:  query.Skip,
+				Limit: query.Limit,
+			},
+		},
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to repository template revisions",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "revisions"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	revisions, _ := records["revisions"].([]template.Revision)
+
+	r.log.Info("revisions process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "revisions"),
+		zap.Any("payload", fmt.Sprintf("%v", query)),
+	)
+	return revisions, nil
+}
+
+func newRevisionsQueryHandler(repo template.Repo, log logger.Logger,
+	tracer tracing.Tracer) cqrs.QueryHandle[template.RevisionsQuery, []template.Revision] {
+	return &revisionsQueryHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+	}
+}
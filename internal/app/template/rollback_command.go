@@ -0,0 +1,137 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/cqrs"
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/postman/internal/business"
+	"github.com/ose-micro/postman/internal/business/template"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Handler
+type rollbackCommandHandler struct {
+	repo   template.Repo
+	log    logger.Logger
+	tracer tracing.Tracer
+	bs     business.Domain
+}
+
+// Handle implements cqrs.CommandHandle.
+func (r *rollbackCommandHandler) Handle(ctx context.Context, command template.RollbackCommand) (*template.Domain, error) {
+	ctx, span := r.tracer.Start(ctx, "app.template.rollback.command.handler", trace.WithAttributes(
+		attribute.String("operation", "rollback"),
+		attribute.String("payload", fmt.Sprintf("%v", command)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// validate command payload
+	if err := command.Validate(); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("validation process fail",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "rollback"),
+			zap.Any("details", err),
+		)
+
+		return nil, err
+	}
+
+	record, err := r.repo.ReadOne(ctx, dto.Request{
+		Queries: []dto.Query{
+			{
+				Name: "one",
+				Filters: []dto.Filter{
+					{
+						Field: "_id",
+						Op:    dto.OpEq,
+						Value: command.Id,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to repository template",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "rollback"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	if record.Version() == command.Version {
+		err := ose_error.New(ose_error.ErrConflict, "template is already at this version", traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("rollback to current version",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "rollback"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	revision, err := r.repo.ReadOneRevision(ctx, revisionRequest(command.Id, command.Version))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to repository template revision",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "rollback"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	// a rollback never rewrites history, it republishes an old revision as the newest one
+	record.Revise(revision.HtmlContent, revision.TextContent, revision.Subject, revision.Placeholders)
+
+	err = r.repo.Update(ctx, *record)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to update template",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "rollback"),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	r.log.Info("rollback process complete successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "rollback"),
+		zap.Any("payload", command),
+	)
+
+	return record, nil
+}
+
+func newRollbackCommandHandler(bs business.Domain, repo template.Repo, log logger.Logger,
+	tracer tracing.Tracer) cqrs.CommandHandle[template.RollbackCommand, *template.Domain] {
+	return &rollbackCommandHandler{
+		repo:   repo,
+		log:    log,
+		tracer: tracer,
+		bs:     bs,
+	}
+}
@@ -3,12 +3,14 @@ package template
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/ose-micro/core/dto"
 	"github.com/ose-micro/core/logger"
 	"github.com/ose-micro/core/tracing"
 	"github.com/ose-micro/cqrs"
 	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/mailer"
 	"github.com/ose-micro/postman/internal/business"
 	"github.com/ose-micro/postman/internal/business/template"
 	"go.opentelemetry.io/otel/attribute"
@@ -21,6 +23,7 @@ import (
 type updateCommandHandler struct {
 	repo   template.Repo
 	log    logger.Logger
+	mailer *mailer.Mailer
 	tracer tracing.Tracer
 	bs     business.Domain
 }
@@ -48,24 +51,11 @@ func (u *updateCommandHandler) Handle(ctx context.Context, command template.Upda
 		return false, err
 	}
 
-	record, err := u.repo.ReadOne(ctx, dto.Request{
-		Queries: []dto.Query{
-			{
-				Name: "one",
-				Filters: []dto.Filter{
-					{
-						Field: "_id",
-						Op:    dto.OpEq,
-						Value: command.Id,
-					},
-				},
-			},
-		},
-	})
-	if err != nil {
+	if err := u.mailer.ValidateData(strings.Join([]string{command.Subject, command.HtmlContent, command.TextContent}, "\n"), command.Placeholders); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrBadRequest, err.Error(), traceId)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		u.log.Error("failed to repository template",
+		u.log.Error("validation process fail",
 			zap.String("trace_id", traceId),
 			zap.String("operation", "update"),
 			zap.Any("details", err),
@@ -74,7 +64,7 @@ func (u *updateCommandHandler) Handle(ctx context.Context, command template.Upda
 		return false, err
 	}
 
-	if check, _ := u.repo.ReadOne(ctx, dto.Request{
+	record, err := u.repo.ReadOne(ctx, dto.Request{
 		Queries: []dto.Query{
 			{
 				Name: "one",
@@ -82,13 +72,13 @@ func (u *updateCommandHandler) Handle(ctx context.Context, command template.Upda
 					{
 						Field: "_id",
 						Op:    dto.OpEq,
-						Value: command.Subject,
+						Value: command.Id,
 					},
 				},
 			},
 		},
-	}); check != nil && check.ID() != record.ID() {
-		err := ose_error.Wrap(err, ose_error.ErrConflict, "template already exist with this subject", traceId)
+	})
+	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		u.log.Error("failed to repository template",
@@ -100,9 +90,23 @@ func (u *updateCommandHandler) Handle(ctx context.Context, command template.Upda
 		return false, err
 	}
 
-	record.SetContent(command.Content).
-		SetSubject(command.Subject).
-		SetPlaceholders(command.Placeholders)
+	if command.Key != "" && command.Key != record.Key() {
+		if check, _ := u.repo.ReadOne(ctx, template.ByField("key", command.Key)); check != nil && check.ID() != record.ID() {
+			err := ose_error.New(ose_error.ErrConflict, "template already exist with this key", traceId)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			u.log.Error("failed to repository template",
+				zap.String("trace_id", traceId),
+				zap.String("operation", "update"),
+				zap.Any("details", err),
+			)
+
+			return false, err
+		}
+	}
+
+	record.SetKey(command.Key).
+		Revise(command.HtmlContent, command.TextContent, command.Subject, command.Placeholders)
 
 	// save template to write store
 	err = u.repo.Update(ctx, *record)
@@ -128,11 +132,12 @@ func (u *updateCommandHandler) Handle(ctx context.Context, command template.Upda
 }
 
 func newUpdateCommandHandler(bs business.Domain, repo template.Repo, log logger.Logger,
-	tracer tracing.Tracer) cqrs.CommandHandle[template.UpdateCommand, bool] {
+	tracer tracing.Tracer, mailer *mailer.Mailer) cqrs.CommandHandle[template.UpdateCommand, bool] {
 	return &updateCommandHandler{
 		repo:   repo,
 		log:    log,
 		tracer: tracer,
+		mailer: mailer,
 		bs:     bs,
 	}
 }
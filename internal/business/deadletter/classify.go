@@ -0,0 +1,19 @@
+package deadletter
+
+import (
+	"errors"
+
+	ose_error "github.com/ose-micro/error"
+)
+
+// Poison reports whether err cannot go away by delivering the message again:
+// the payload is invalid or names something that does not exist. Anything
+// else, a store or network failure included, is worth retrying.
+func Poison(err error) bool {
+	var failure *ose_error.Error
+	if !errors.As(err, &failure) {
+		return false
+	}
+
+	return failure.Code == ose_error.ErrBadRequest || failure.Code == ose_error.ErrNotFound
+}
@@ -0,0 +1,28 @@
+package deadletter
+
+import (
+	"context"
+
+	"github.com/ose-micro/core/dto"
+)
+
+type Repo interface {
+	// Create keeps letter and queues it for Stream in one transaction.
+	Create(ctx context.Context, letter Letter) error
+	Read(ctx context.Context, request dto.Request) (map[string]any, error)
+	ReadOne(ctx context.Context, id string) (*Letter, error)
+	Update(ctx context.Context, letter Letter) error
+	// Purge deletes the letter with id, or every letter when id is empty, and
+	// reports how many it deleted.
+	Purge(ctx context.Context, id string) (int64, error)
+}
+
+type App interface {
+	// Settle returns the letter when the message was given up on, and nil
+	// when it succeeded or should be delivered again.
+	Settle(ctx context.Context, command SettleCommand) (*Letter, error)
+	Read(ctx context.Context, request dto.Request) (map[string]any, error)
+	Inspect(ctx context.Context, query InspectQuery) (*Letter, error)
+	Replay(ctx context.Context, command ReplayCommand) (*Letter, error)
+	Purge(ctx context.Context, command PurgeCommand) (int64, error)
+}
@@ -0,0 +1,99 @@
+package deadletter
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ose-micro/rid"
+)
+
+// Stream is the NATS stream dead letters are published onto.
+const Stream = "POSTMAN_DLQ"
+
+// Kind is why a message was given up on.
+type Kind string
+
+const (
+	// KindPoison is a message that can never succeed, such as a malformed
+	// payload or one naming a template that does not exist.
+	KindPoison Kind = "poison"
+	// KindExhausted is a message that kept failing transiently until it ran
+	// out of deliveries.
+	KindExhausted Kind = "exhausted"
+)
+
+// State is where a dead letter is in being dealt with.
+type State string
+
+const (
+	StateDead     State = "dead"
+	StateReplayed State = "replayed"
+)
+
+// Letter is a message a consumer gave up on, kept with its original payload
+// so it can be inspected and replayed.
+type Letter struct {
+	Id string `json:"_id"`
+	// Subject is the subject the message was consumed from and is replayed to.
+	Subject string `json:"subject"`
+	// Payload is the message as it was received.
+	Payload    string     `json:"payload"`
+	Reason     string     `json:"reason"`
+	Kind       Kind       `json:"kind"`
+	Attempts   int32      `json:"attempts"`
+	State      State      `json:"state"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ReplayedAt *time.Time `json:"replayed_at"`
+}
+
+// NewLetter gives up on payload consumed from subject after attempts deliveries.
+func NewLetter(subject, payload, reason string, kind Kind, attempts int32) Letter {
+	return Letter{
+		Id:        rid.New("dlq", true).String(),
+		Subject:   subject,
+		Payload:   payload,
+		Reason:    reason,
+		Kind:      kind,
+		Attempts:  attempts,
+		State:     StateDead,
+		CreatedAt: time.Now(),
+	}
+}
+
+// Replayed records that the payload was published to Subject again.
+func (l *Letter) Replayed() {
+	now := time.Now()
+
+	l.State = StateReplayed
+	l.ReplayedAt = &now
+}
+
+// Message is the data of the event published onto Stream for the letter.
+type Message struct {
+	Id       string    `json:"id"`
+	Subject  string    `json:"subject"`
+	Payload  string    `json:"payload"`
+	Reason   string    `json:"reason"`
+	Kind     Kind      `json:"kind"`
+	Attempts int32     `json:"attempts"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// Message describes the letter for Stream.
+func (l Letter) Message() Message {
+	return Message{
+		Id:       l.Id,
+		Subject:  l.Subject,
+		Payload:  l.Payload,
+		Reason:   l.Reason,
+		Kind:     l.Kind,
+		Attempts: l.Attempts,
+		FailedAt: l.CreatedAt,
+	}
+}
+
+// Topic returns the subject dead letters of subject are published on, so
+// postman.send_mail becomes postman.dlq.send_mail.
+func Topic(subject string) string {
+	return "postman.dlq." + strings.TrimPrefix(subject, "postman.")
+}
@@ -0,0 +1,36 @@
+package deadletter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ose-micro/cqrs"
+)
+
+// PurgeCommand deletes the dead letter with Id, or every dead letter when All is set.
+type PurgeCommand struct {
+	Id  string
+	All bool
+}
+
+// CommandName implements cqrs.Command.
+func (c PurgeCommand) CommandName() string {
+	return "postman.deadletter.purge.command"
+}
+
+// Validate implements cqrs.Command.
+func (c PurgeCommand) Validate() error {
+	fields := make([]string, 0)
+
+	if (c.Id == "") != c.All {
+		fields = append(fields, "exactly one of id or all is required")
+	}
+
+	if len(fields) > 0 {
+		return fmt.Errorf("%s", strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+var _ cqrs.Command = PurgeCommand{}
@@ -0,0 +1,30 @@
+package deadletter
+
+import (
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/cqrs"
+)
+
+type ReadQuery struct {
+	Request dto.Request
+}
+
+// QueryName implements cqrs.Query.
+func (c ReadQuery) QueryName() string {
+	return "deadletter.read.query"
+}
+
+// InspectQuery reads the dead letter with Id.
+type InspectQuery struct {
+	Id string
+}
+
+// QueryName implements cqrs.Query.
+func (c InspectQuery) QueryName() string {
+	return "deadletter.inspect.query"
+}
+
+var (
+	_ cqrs.Query = ReadQuery{}
+	_ cqrs.Query = InspectQuery{}
+)
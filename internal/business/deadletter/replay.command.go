@@ -0,0 +1,36 @@
+package deadletter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ose-micro/cqrs"
+)
+
+// ReplayCommand publishes the payload of the dead letter with Id to its
+// subject again.
+type ReplayCommand struct {
+	Id string
+}
+
+// CommandName implements cqrs.Command.
+func (c ReplayCommand) CommandName() string {
+	return "postman.deadletter.replay.command"
+}
+
+// Validate implements cqrs.Command.
+func (c ReplayCommand) Validate() error {
+	fields := make([]string, 0)
+
+	if c.Id == "" {
+		fields = append(fields, "id is required")
+	}
+
+	if len(fields) > 0 {
+		return fmt.Errorf("%s", strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+var _ cqrs.Command = ReplayCommand{}
@@ -0,0 +1,50 @@
+package deadletter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ose-micro/cqrs"
+)
+
+// SettleCommand records how handling Payload, consumed from Subject, went.
+// A failed message is dead lettered when Failure is poison or it was the
+// MaxDeliver-th delivery; Delivered is the count the bus keeps, this delivery
+// included.
+type SettleCommand struct {
+	Subject    string
+	Payload    string
+	Failure    error
+	Delivered  int32
+	MaxDeliver int32
+}
+
+// CommandName implements cqrs.Command.
+func (c SettleCommand) CommandName() string {
+	return "postman.deadletter.settle.command"
+}
+
+// Validate implements cqrs.Command.
+func (c SettleCommand) Validate() error {
+	fields := make([]string, 0)
+
+	if c.Subject == "" {
+		fields = append(fields, "subject is required")
+	}
+
+	if c.Delivered <= 0 {
+		fields = append(fields, "delivered must be positive")
+	}
+
+	if c.MaxDeliver <= 0 {
+		fields = append(fields, "max deliver must be positive")
+	}
+
+	if len(fields) > 0 {
+		return fmt.Errorf("%s", strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+var _ cqrs.Command = SettleCommand{}
@@ -4,17 +4,23 @@ import (
 	"github.com/ose-micro/core/domain"
 	"github.com/ose-micro/core/timestamp"
 	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/postman/internal/business/subscription"
+	"github.com/ose-micro/postman/internal/business/suppression"
 	"github.com/ose-micro/postman/internal/business/template"
 )
 
 type Domain struct {
-	Template domain.Domain[template.Domain, template.Params]
-	Email    domain.Domain[email.Domain, email.Params]
+	Template     domain.Domain[template.Domain, template.Params]
+	Email        domain.Domain[email.Domain, email.Params]
+	Suppression  domain.Domain[suppression.Domain, suppression.Params]
+	Subscription domain.Domain[subscription.Domain, subscription.Params]
 }
 
 func InjectDomain(timestamp timestamp.Timestamp) Domain {
 	return Domain{
-		Template: template.NewTemplateDomain(timestamp),
-		Email:    email.NewEmailDomain(timestamp),
+		Template:     template.NewTemplateDomain(timestamp),
+		Email:        email.NewEmailDomain(timestamp),
+		Suppression:  suppression.NewSuppressionDomain(timestamp),
+		Subscription: subscription.NewSubscriptionDomain(timestamp),
 	}
 }
@@ -0,0 +1,19 @@
+package email
+
+import (
+	"fmt"
+	"net/mail"
+)
+
+// invalidAddresses returns a validation message for every entry of list that is
+// not an RFC 5322 address, such as "Jane Doe <jane@example.com>".
+func invalidAddresses(field string, list ...string) []string {
+	fields := make([]string, 0)
+	for _, address := range list {
+		if _, err := mail.ParseAddress(address); err != nil {
+			fields = append(fields, fmt.Sprintf("%s %q is not a valid address", field, address))
+		}
+	}
+
+	return fields
+}
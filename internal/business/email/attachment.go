@@ -0,0 +1,54 @@
+package email
+
+import "fmt"
+
+// Attachment is the stored metadata of a file sent with an email. Its bytes live
+// in the blob store under Blob so queued emails and resends can rebuild the message.
+type Attachment struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	Blob        string `json:"blob"`
+	// ContentId is referenced from the HTML body as cid:ContentId when Inline is set.
+	ContentId string `json:"content_id"`
+	Inline    bool   `json:"inline"`
+}
+
+// NewAttachment is an attachment as supplied by a caller, carrying either the
+// bytes themselves or the id of a blob stored by an earlier email.
+type NewAttachment struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	Content     []byte `json:"content,omitempty"`
+	Blob        string `json:"blob,omitempty"`
+	ContentId   string `json:"content_id,omitempty"`
+	Inline      bool   `json:"inline,omitempty"`
+}
+
+// String describes the attachment by name, content type and size. The bytes
+// are left out so commands can be logged and traced as they are.
+func (a NewAttachment) String() string {
+	if a.Blob != "" {
+		return fmt.Sprintf("{%s %s blob %s}", a.Filename, a.ContentType, a.Blob)
+	}
+
+	return fmt.Sprintf("{%s %s %d bytes}", a.Filename, a.ContentType, len(a.Content))
+}
+
+func (a NewAttachment) validate(index int) []string {
+	fields := make([]string, 0)
+
+	if a.Filename == "" {
+		fields = append(fields, fmt.Sprintf("attachment %d filename is required", index))
+	}
+
+	if (len(a.Content) == 0) == (a.Blob == "") {
+		fields = append(fields, fmt.Sprintf("attachment %d needs either content or blob", index))
+	}
+
+	if a.Inline && a.ContentId == "" {
+		fields = append(fields, fmt.Sprintf("attachment %d content id is required when inline", index))
+	}
+
+	return fields
+}
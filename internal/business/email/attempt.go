@@ -0,0 +1,17 @@
+package email
+
+import "time"
+
+// Attempt records the outcome of a single delivery try.
+type Attempt struct {
+	At       time.Time `json:"at"`
+	Provider string    `json:"provider"`
+	// Code and Response are the SMTP reply, when the server gave one.
+	Code       int32  `json:"code"`
+	Response   string `json:"response"`
+	Error      string `json:"error"`
+	DurationMs int64  `json:"duration_ms"`
+	MessageId  string `json:"message_id"`
+	// ProviderId is the id an API provider assigned to the message, used by its event webhooks.
+	ProviderId string `json:"provider_id"`
+}
@@ -3,16 +3,36 @@ package email
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ose-micro/cqrs"
 )
 
 type CreateCommand struct {
+	// Recipient is a single To address, kept for callers that predate To.
 	Recipient string
-	Sender    string
-	Data      map[string]interface{}
-	Template  string
-	From      string
+	To        []string
+	Cc        []string
+	// Bcc receives the email without appearing in any header.
+	Bcc     []string
+	ReplyTo string
+	Sender  string
+	Data    map[string]interface{}
+	// Template is either the template id or its key.
+	Template string
+	From     string
+	// SendAt schedules delivery; nil or a past time sends as soon as possible.
+	SendAt *time.Time
+	// IdempotencyKey makes retried creates return the original email.
+	IdempotencyKey string
+	Attachments    []NewAttachment
+	// Headers are extra message headers such as List-Id or In-Reply-To.
+	Headers map[string]string
+	// Tags are stored with the email for filtering as tags.<key>; they are not sent.
+	Tags map[string]string
+	// Category, e.g. marketing or transactional, selects which scoped
+	// suppressions apply.
+	Category string
 }
 
 // CommandName implements cqrs.Command.
@@ -24,8 +44,27 @@ func (c CreateCommand) CommandName() string {
 func (c CreateCommand) Validate() error {
 	fields := make([]string, 0)
 
-	if c.Recipient == "" {
-		fields = append(fields, "recipient is required")
+	if c.Recipient == "" && len(c.To) == 0 {
+		fields = append(fields, "recipient or to is required")
+	}
+
+	if c.Recipient != "" {
+		fields = append(fields, invalidAddresses("recipient", c.Recipient)...)
+	}
+
+	fields = append(fields, invalidAddresses("to", c.To...)...)
+	fields = append(fields, invalidAddresses("cc", c.Cc...)...)
+	fields = append(fields, invalidAddresses("bcc", c.Bcc...)...)
+
+	if c.ReplyTo != "" {
+		fields = append(fields, invalidAddresses("reply to", c.ReplyTo)...)
+	}
+
+	fields = append(fields, invalidHeaders(c.Headers)...)
+	fields = append(fields, invalidTags(c.Tags)...)
+
+	for i, attachment := range c.Attachments {
+		fields = append(fields, attachment.validate(i)...)
 	}
 
 	if c.Template == "" {
@@ -43,4 +82,11 @@ func (c CreateCommand) Validate() error {
 	return nil
 }
 
+// String prints the command with attachments reduced to their metadata, which
+// also keeps their bytes out of zap fields.
+func (c CreateCommand) String() string {
+	type createCommand CreateCommand
+	return fmt.Sprintf("%+v", createCommand(c))
+}
+
 var _ cqrs.Command = CreateCommand{}
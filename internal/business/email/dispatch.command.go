@@ -0,0 +1,42 @@
+package email
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ose-micro/cqrs"
+)
+
+// DispatchCommand claims the next queued email for Lease and delivers it,
+// rescheduling transient failures according to Retry.
+type DispatchCommand struct {
+	Lease time.Duration
+	Retry RetryPolicy
+}
+
+// CommandName implements cqrs.Command.
+func (d DispatchCommand) CommandName() string {
+	return "postman.email.dispatch.command"
+}
+
+// Validate implements cqrs.Command.
+func (d DispatchCommand) Validate() error {
+	fields := make([]string, 0)
+
+	if d.Lease <= 0 {
+		fields = append(fields, "lease must be positive")
+	}
+
+	if d.Retry.MaxAttempts <= 0 {
+		fields = append(fields, "max attempts must be positive")
+	}
+
+	if len(fields) > 0 {
+		return fmt.Errorf("%s", strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+var _ cqrs.Command = DispatchCommand{}
@@ -7,53 +7,105 @@ import (
 	"github.com/ose-micro/rid"
 )
 
-type State string
-
-const (
-	StateComplete State = "Complement"
-	StateFailed   State = "Failed"
-)
-
 type Domain struct {
 	*domain.Aggregate
-	recipient string
-	sender    string
-	from      string
-	subject   string
-	template  string
-	data      map[string]interface{}
-	message   string
-	state     State
+	recipient       string
+	to              []string
+	cc              []string
+	bcc             []string
+	replyTo         string
+	sender          string
+	from            string
+	subject         string
+	template        string
+	templateVersion int32
+	data            map[string]interface{}
+	message         string
+	text            string
+	state           State
+	tries           int32
+	attempts        []Attempt
+	lastError       string
+	retryAt         *time.Time
+	sendAt          *time.Time
+	idempotencyKey  string
+	attachments     []Attachment
+	headers         map[string]string
+	tags            map[string]string
+	messageId       string
+	provider        string
+	category        string
+	suppressed      []string
+	// changes are the transitions made since the email was loaded; they are not stored.
+	changes []Change
 }
 
 type Public struct {
-	Id        string                 `json:"_id"`
-	Recipient string                 `json:"recipient"`
-	Sender    string                 `json:"sender"`
-	Subject   string                 `json:"subject"`
-	Count     int32                  `json:"count"`
-	Data      map[string]interface{} `json:"data"`
-	Template  string                 `json:"template"`
-	From      string                 `json:"from"`
-	Message   string                 `json:"message"`
-	Version   int32                  `json:"version"`
-	State     State                  `json:"status"`
-	CreatedAt time.Time              `json:"created_at"`
-	UpdatedAt time.Time              `json:"updated_at"`
-	DeletedAt *time.Time             `json:"deleted_at"`
-	Events    []domain.Event         `json:"events"`
+	Id              string                 `json:"_id"`
+	Recipient       string                 `json:"recipient"`
+	To              []string               `json:"to"`
+	Cc              []string               `json:"cc"`
+	Bcc             []string               `json:"bcc"`
+	ReplyTo         string                 `json:"reply_to"`
+	Sender          string                 `json:"sender"`
+	Subject         string                 `json:"subject"`
+	Count           int32                  `json:"count"`
+	Data            map[string]interface{} `json:"data"`
+	Template        string                 `json:"template"`
+	TemplateVersion int32                  `json:"template_version"`
+	From            string                 `json:"from"`
+	Message         string                 `json:"message"`
+	Text            string                 `json:"text"`
+	Version         int32                  `json:"version"`
+	State           State                  `json:"state"`
+	Tries           int32                  `json:"tries"`
+	Attempts        []Attempt              `json:"attempts"`
+	LastError       string                 `json:"last_error"`
+	RetryAt         *time.Time             `json:"retry_at"`
+	SendAt          *time.Time             `json:"send_at"`
+	IdempotencyKey  string                 `json:"idempotency_key"`
+	Attachments     []Attachment           `json:"attachments"`
+	Headers         map[string]string      `json:"headers"`
+	Tags            map[string]string      `json:"tags"`
+	MessageId       string                 `json:"message_id"`
+	Provider        string                 `json:"provider"`
+	Category        string                 `json:"category"`
+	Suppressed      []string               `json:"suppressed"`
+	CreatedAt       time.Time              `json:"created_at"`
+	UpdatedAt       time.Time              `json:"updated_at"`
+	DeletedAt       *time.Time             `json:"deleted_at"`
+	Events          []domain.Event         `json:"events"`
 }
 
 type Params struct {
 	*domain.Aggregate
-	Recipient string
-	Sender    string
-	Subject   string
-	Data      map[string]interface{}
-	Template  string
-	From      string
-	Message   string
-	State     State
+	Recipient       string
+	To              []string
+	Cc              []string
+	Bcc             []string
+	ReplyTo         string
+	Sender          string
+	Subject         string
+	Data            map[string]interface{}
+	Template        string
+	TemplateVersion int32
+	From            string
+	Message         string
+	Text            string
+	State           State
+	Tries           int32
+	Attempts        []Attempt
+	LastError       string
+	RetryAt         *time.Time
+	SendAt          *time.Time
+	IdempotencyKey  string
+	Attachments     []Attachment
+	Headers         map[string]string
+	Tags            map[string]string
+	MessageId       string
+	Provider        string
+	Category        string
+	Suppressed      []string
 }
 
 func (p Public) Params() *Params {
@@ -67,14 +119,33 @@ func (p Public) Params() *Params {
 	aggregate := domain.ExistingAggregate(*id, version, createdAt, updatedAt, deletedAt, events)
 
 	return &Params{
-		Aggregate: aggregate,
-		Recipient: p.Recipient,
-		Sender:    p.Sender,
-		Subject:   p.Subject,
-		Data:      p.Data,
-		Template:  p.Template,
-		From:      p.From,
-		Message:   p.Message,
-		State:     p.State,
+		Aggregate:       aggregate,
+		Recipient:       p.Recipient,
+		To:              p.To,
+		Cc:              p.Cc,
+		Bcc:             p.Bcc,
+		ReplyTo:         p.ReplyTo,
+		Sender:          p.Sender,
+		Subject:         p.Subject,
+		Data:            p.Data,
+		Template:        p.Template,
+		TemplateVersion: p.TemplateVersion,
+		From:            p.From,
+		Message:         p.Message,
+		Text:            p.Text,
+		State:           p.State.Canonical(),
+		Tries:           p.Tries,
+		Attempts:        p.Attempts,
+		LastError:       p.LastError,
+		RetryAt:         p.RetryAt,
+		SendAt:          p.SendAt,
+		IdempotencyKey:  p.IdempotencyKey,
+		Attachments:     p.Attachments,
+		Headers:         p.Headers,
+		Tags:            p.Tags,
+		MessageId:       p.MessageId,
+		Provider:        p.Provider,
+		Category:        p.Category,
+		Suppressed:      p.Suppressed,
 	}
 }
@@ -1,9 +1,35 @@
 package email
 
+import (
+	"fmt"
+	"time"
+)
+
 func (d *Domain) Recipient() string {
 	return d.recipient
 }
 
+// To lists the primary recipients, falling back to Recipient for emails stored before lists existed.
+func (d *Domain) To() []string {
+	if len(d.to) == 0 && d.recipient != "" {
+		return []string{d.recipient}
+	}
+
+	return d.to
+}
+
+func (d *Domain) Cc() []string {
+	return d.cc
+}
+
+func (d *Domain) Bcc() []string {
+	return d.bcc
+}
+
+func (d *Domain) ReplyTo() string {
+	return d.replyTo
+}
+
 func (d *Domain) Data() map[string]interface{} {
 	return d.data
 }
@@ -24,30 +50,242 @@ func (d *Domain) Template() string {
 	return d.template
 }
 
+// TemplateVersion is the template revision the message was rendered from.
+func (d *Domain) TemplateVersion() int32 {
+	return d.templateVersion
+}
+
 func (d *Domain) Message() string {
 	return d.message
 }
 
+// Text is the rendered plain-text alternative of Message.
+func (d *Domain) Text() string {
+	return d.text
+}
+
 func (d *Domain) State() State {
 	return d.state
 }
 
-func (d *Domain) SetState(state State) {
-	d.state = state
+// Tries is the number of deliveries tried since the email was last queued.
+func (d *Domain) Tries() int32 {
+	return d.tries
+}
+
+// Attempts is the full delivery history, oldest first, kept across resends.
+func (d *Domain) Attempts() []Attempt {
+	return d.attempts
+}
+
+func (d *Domain) LastError() string {
+	return d.lastError
+}
+
+// RetryAt is the earliest time a queued email may be claimed again.
+func (d *Domain) RetryAt() *time.Time {
+	return d.retryAt
+}
+
+// SendAt is when a scheduled email is released to the queue.
+func (d *Domain) SendAt() *time.Time {
+	return d.sendAt
+}
+
+func (d *Domain) IdempotencyKey() string {
+	return d.idempotencyKey
+}
+
+func (d *Domain) Attachments() []Attachment {
+	return d.attachments
+}
+
+func (d *Domain) Headers() map[string]string {
+	return d.headers
+}
+
+func (d *Domain) Tags() map[string]string {
+	return d.tags
+}
+
+// MessageId is the Message-ID header of the most recent delivery attempt.
+func (d *Domain) MessageId() string {
+	return d.messageId
+}
+
+// Provider names the transport that delivered the email.
+func (d *Domain) Provider() string {
+	return d.provider
+}
+
+// Category groups emails such as marketing or transactional for suppression scoping.
+func (d *Domain) Category() string {
+	return d.category
+}
+
+// Suppressed lists the recipients dropped at creation because they are suppressed.
+func (d *Domain) Suppressed() []string {
+	return d.suppressed
+}
+
+// Changes lists the state transitions made since the email was created or loaded.
+func (d *Domain) Changes() []Change {
+	return d.changes
+}
+
+// NextMessageId derives the Message-ID for the coming attempt from the email id,
+// so every attempt is distinct yet traceable back to the record.
+func (d *Domain) NextMessageId(domain string) string {
+	return fmt.Sprintf("<%s.%d@%s>", d.ID(), len(d.attempts)+1, domain)
+}
+
+// RecordAttempt appends a finished delivery try to the history.
+func (d *Domain) RecordAttempt(attempt Attempt) {
+	d.tries++
+	d.attempts = append(d.attempts, attempt)
+	if attempt.MessageId != "" {
+		d.messageId = attempt.MessageId
+	}
+}
+
+// Sent records that provider accepted the email.
+func (d *Domain) Sent(provider string) error {
+	if err := d.Transition(StateSent); err != nil {
+		return err
+	}
+
+	d.provider = provider
+
+	return nil
+}
+
+// Retry puts the email back on the queue once at has passed.
+func (d *Domain) Retry(at time.Time, reason string) error {
+	if err := d.Transition(StateQueued); err != nil {
+		return err
+	}
+
+	d.retryAt = &at
+	d.lastError = reason
+
+	return nil
+}
+
+// Defer puts the email back on the queue until at without counting a try,
+// used when a rate limit holds it back.
+func (d *Domain) Defer(at time.Time) error {
+	if err := d.Transition(StateQueued); err != nil {
+		return err
+	}
+
+	d.retryAt = &at
+
+	return nil
+}
+
+// Suppress drops recipients suppressed after the email was created. Once no To
+// recipient is left the copies have nothing to accompany, so the email moves
+// to Suppressed and is never sent.
+func (d *Domain) Suppress(addresses []string) error {
+	drop := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		drop[address] = true
+	}
+
+	keep := func(list []string) []string {
+		kept := make([]string, 0, len(list))
+		for _, address := range list {
+			if !drop[address] {
+				kept = append(kept, address)
+			}
+		}
+
+		return kept
+	}
+
+	to := keep(d.To())
+	if len(to) == 0 {
+		if err := d.Transition(StateSuppressed); err != nil {
+			return err
+		}
+
+		d.retryAt = nil
+		d.lastError = "every to recipient is suppressed"
+	} else {
+		d.recipient, d.to, d.cc, d.bcc = to[0], to, keep(d.cc), keep(d.bcc)
+	}
+	d.suppressed = append(d.suppressed, addresses...)
+
+	return nil
+}
+
+// Bounce records that a sent email came back undelivered.
+func (d *Domain) Bounce(reason string) error {
+	if err := d.Transition(StateBounced); err != nil {
+		return err
+	}
+
+	d.lastError = reason
+
+	return nil
+}
+
+// Fail gives up on the email.
+func (d *Domain) Fail(reason string) error {
+	if err := d.Transition(StateFailed); err != nil {
+		return err
+	}
+
+	d.retryAt = nil
+	d.lastError = reason
+
+	return nil
+}
+
+// Requeue queues the email immediately with a fresh attempt budget.
+func (d *Domain) Requeue() error {
+	if err := d.Transition(StateQueued); err != nil {
+		return err
+	}
+
+	d.retryAt = nil
+	d.tries = 0
+
+	return nil
 }
 
 func (d *Domain) Public() Public {
 	return Public{
-		Id:        d.ID(),
-		Recipient: d.recipient,
-		Sender:    d.sender,
-		Subject:   d.subject,
-		Data:      d.data,
-		Template:  d.template,
-		From:      d.from,
-		State:     d.state,
-		Message:   d.message,
-		CreatedAt: d.CreatedAt(),
-		UpdatedAt: d.UpdatedAt(),
+		Id:              d.ID(),
+		Recipient:       d.recipient,
+		To:              d.To(),
+		Cc:              d.cc,
+		Bcc:             d.bcc,
+		ReplyTo:         d.replyTo,
+		Sender:          d.sender,
+		Subject:         d.subject,
+		Data:            d.data,
+		Template:        d.template,
+		TemplateVersion: d.templateVersion,
+		From:            d.from,
+		State:           d.state,
+		Message:         d.message,
+		Count:           int32(len(d.attempts)),
+		Tries:           d.tries,
+		Attempts:        d.attempts,
+		LastError:       d.lastError,
+		RetryAt:         d.retryAt,
+		SendAt:          d.sendAt,
+		IdempotencyKey:  d.idempotencyKey,
+		Attachments:     d.attachments,
+		Headers:         d.headers,
+		Tags:            d.tags,
+		MessageId:       d.messageId,
+		Provider:        d.provider,
+		Category:        d.category,
+		Suppressed:      d.suppressed,
+		Text:            d.text,
+		CreatedAt:       d.CreatedAt(),
+		UpdatedAt:       d.UpdatedAt(),
 	}
 }
@@ -0,0 +1,94 @@
+package email
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ose-micro/cqrs"
+)
+
+// EventType names what a provider or bounce report says happened to a sent email.
+type EventType string
+
+const (
+	EventDelivered  EventType = "delivered"
+	EventDeferred   EventType = "deferred"
+	EventBounced    EventType = "bounced"
+	EventComplained EventType = "complained"
+	EventOpened     EventType = "opened"
+	EventClicked    EventType = "clicked"
+)
+
+// Valid reports whether t is a known event type.
+func (t EventType) Valid() bool {
+	switch t {
+	case EventDelivered, EventDeferred, EventBounced, EventComplained, EventOpened, EventClicked:
+		return true
+	}
+
+	return false
+}
+
+// State is the state the event moves an email to, or empty when it changes
+// nothing. Opens and clicks prove delivery, so they count as delivered.
+func (t EventType) State() State {
+	switch t {
+	case EventDelivered, EventOpened, EventClicked:
+		return StateDelivered
+	case EventBounced:
+		return StateBounced
+	case EventComplained:
+		return StateComplained
+	}
+
+	return ""
+}
+
+// EventCommand reports something that happened to a sent email after the
+// provider accepted it, such as a delivery, bounce or complaint.
+type EventCommand struct {
+	Type EventType
+	// MessageId is the Message-ID of the attempt the event is about.
+	MessageId string
+	// ProviderId is the id the provider gave the attempt, used when the
+	// event does not carry the Message-ID.
+	ProviderId string
+	Recipient  string
+	// Status is the enhanced status code, e.g. 5.1.1.
+	Status     string
+	Diagnostic string
+	// Permanent marks a hard bounce, whose recipient is suppressed.
+	Permanent bool
+	// Source names what reported the event, such as dsn or a provider.
+	Source string
+	// Url is the link followed by a click event.
+	Url string
+	At  time.Time
+}
+
+// CommandName implements cqrs.Command.
+func (c EventCommand) CommandName() string {
+	return "postman.email.event.command"
+}
+
+// Validate implements cqrs.Command.
+func (c EventCommand) Validate() error {
+	fields := make([]string, 0)
+
+	if !c.Type.Valid() {
+		fields = append(fields, "type is invalid")
+	}
+
+	if c.MessageId == "" && c.ProviderId == "" {
+		fields = append(fields, "message id or provider id is required")
+	}
+
+	if len(fields) > 0 {
+		return fmt.Errorf("%s", strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+var _ cqrs.Command = EventCommand{}
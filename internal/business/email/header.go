@@ -0,0 +1,77 @@
+package email
+
+import (
+	"fmt"
+	"net/textproto"
+	"sort"
+	"strings"
+)
+
+// reservedHeaders are built by the service and cannot be overridden by callers.
+var reservedHeaders = map[string]bool{
+	"From":                      true,
+	"Sender":                    true,
+	"To":                        true,
+	"Cc":                        true,
+	"Bcc":                       true,
+	"Reply-To":                  true,
+	"Subject":                   true,
+	"Date":                      true,
+	"Message-Id":                true,
+	"Mime-Version":              true,
+	"Content-Type":              true,
+	"Content-Transfer-Encoding": true,
+}
+
+func invalidHeaders(headers map[string]string) []string {
+	fields := make([]string, 0)
+	for _, name := range sortedKeys(headers) {
+		switch {
+		case !validHeaderName(name):
+			fields = append(fields, fmt.Sprintf("header %q is not a valid field name", name))
+		case reservedHeaders[textproto.CanonicalMIMEHeaderKey(name)]:
+			fields = append(fields, fmt.Sprintf("header %q is set by the service", name))
+		case strings.ContainsAny(headers[name], "\r\n"):
+			fields = append(fields, fmt.Sprintf("header %q must not contain line breaks", name))
+		}
+	}
+
+	return fields
+}
+
+// validHeaderName follows RFC 5322 section 3.6.8: printable ASCII except colon.
+func validHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, c := range name {
+		if c < 33 || c > 126 || c == ':' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// invalidTags rejects keys Mongo cannot address as tags.<key> in a filter.
+func invalidTags(tags map[string]string) []string {
+	fields := make([]string, 0)
+	for _, key := range sortedKeys(tags) {
+		if key == "" || strings.ContainsAny(key, ".$") {
+			fields = append(fields, fmt.Sprintf("tag %q must be non-empty without '.' or '$'", key))
+		}
+	}
+
+	return fields
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
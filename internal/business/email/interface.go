@@ -2,6 +2,7 @@ package email
 
 import (
 	"context"
+	"time"
 
 	"github.com/ose-micro/core/dto"
 )
@@ -15,10 +16,39 @@ type Repo interface {
 	Read(ctx context.Context, request dto.Request) (map[string]any, error)
 	ReadOne(ctx context.Context, request dto.Request) (*Domain, error)
 	Update(ctx context.Context, payload Domain) error
+	// Claim moves the oldest due queued email, or a Sending one whose lease
+	// expired, to Sending under a new lease. It returns nil when nothing is waiting.
+	Claim(ctx context.Context, lease time.Duration) (*Domain, *Lease, error)
+	// Settle saves the outcome of a dispatch and releases lease, only while the
+	// email is still Sending under it, so a worker whose lease ran out never
+	// overwrites the worker that reclaimed the email.
+	Settle(ctx context.Context, payload Domain, lease Lease) error
+	// Release queues scheduled emails due at or before at and returns them.
+	Release(ctx context.Context, at time.Time) ([]*Domain, error)
+	// UpdateFrom saves payload only while the stored email is still in state from,
+	// so a concurrent dispatcher claim is never overwritten.
+	UpdateFrom(ctx context.Context, payload Domain, from State) error
+	// Supersede creates payload and takes its idempotency key from the email
+	// with id previous, whose window has passed, in one transaction, so a
+	// failed create leaves the key where it was.
+	Supersede(ctx context.Context, payload Domain, previous string) error
+	// CreateBlob stores attachment bytes and returns the blob id.
+	CreateBlob(ctx context.Context, content []byte) (string, error)
+	ReadBlob(ctx context.Context, id string) ([]byte, error)
+	// DeleteBlobs removes blobs no email refers to, such as those stored for a
+	// create that then failed.
+	DeleteBlobs(ctx context.Context, ids []string) error
 }
 
 type App interface {
 	Create(ctx context.Context, command CreateCommand) (*Domain, error)
 	Read(ctx context.Context, request dto.Request) (map[string]any, error)
 	Resend(ctx context.Context, command IdCommand) error
+	// Dispatch delivers one queued email, returning nil when the queue is empty.
+	Dispatch(ctx context.Context, command DispatchCommand) (*Domain, error)
+	Release(ctx context.Context, command ReleaseCommand) (int64, error)
+	Cancel(ctx context.Context, command IdCommand) (*Domain, error)
+	// Event applies a delivery event to the email the attempt Message-ID or
+	// provider id belongs to, returning nil when no email matches.
+	Event(ctx context.Context, command EventCommand) (*Domain, error)
 }
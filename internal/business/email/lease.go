@@ -0,0 +1,10 @@
+package email
+
+import "time"
+
+// Lease is a dispatcher's claim on a Sending email. Token tells this claim
+// apart from a later one taken by another worker once Until has passed.
+type Lease struct {
+	Token string
+	Until time.Time
+}
@@ -0,0 +1,36 @@
+package email
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ose-micro/cqrs"
+)
+
+// ReleaseCommand queues every scheduled email whose send time is at or before At.
+type ReleaseCommand struct {
+	At time.Time
+}
+
+// CommandName implements cqrs.Command.
+func (r ReleaseCommand) CommandName() string {
+	return "postman.email.release.command"
+}
+
+// Validate implements cqrs.Command.
+func (r ReleaseCommand) Validate() error {
+	fields := make([]string, 0)
+
+	if r.At.IsZero() {
+		fields = append(fields, "at is required")
+	}
+
+	if len(fields) > 0 {
+		return fmt.Errorf("%s", strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+var _ cqrs.Command = ReleaseCommand{}
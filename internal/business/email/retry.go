@@ -0,0 +1,40 @@
+package email
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy decides when a transient delivery failure is attempted again.
+type RetryPolicy struct {
+	MaxAttempts int32
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	// Jitter is the fraction, between 0 and 1, by which a delay is randomly shortened
+	// so emails failing together do not retry together.
+	Jitter float64
+}
+
+// Exhausted reports whether no attempts are left after the given number were made.
+func (p RetryPolicy) Exhausted(attempts int32) bool {
+	return attempts >= p.MaxAttempts
+}
+
+// Backoff returns the delay before the attempt following the given one,
+// doubling BaseDelay per attempt up to MaxDelay.
+func (p RetryPolicy) Backoff(attempts int32) time.Duration {
+	delay := p.BaseDelay
+	for i := int32(1); i < attempts && (p.MaxDelay <= 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	if p.Jitter > 0 {
+		delay -= time.Duration(rand.Float64() * p.Jitter * float64(delay))
+	}
+
+	return delay
+}
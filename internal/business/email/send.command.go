@@ -3,18 +3,30 @@ package email
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ose-micro/cqrs"
 )
 
 type SendCommand struct {
 	Recipient string                 `json:"recipient"`
+	To        []string               `json:"to,omitempty"`
+	Cc        []string               `json:"cc,omitempty"`
+	Bcc       []string               `json:"bcc,omitempty"`
+	ReplyTo   string                 `json:"reply_to,omitempty"`
 	Sender    string                 `json:"sender"`
 	Subject   string                 `json:"subject"`
 	Data      map[string]interface{} `json:"data"`
-	Template  string                 `json:"template"`
+	Template  string                 `json:"template"` // template id or key
 	From      string                 `json:"from"`
 	Message   string                 `json:"message"`
+	SendAt    *time.Time             `json:"send_at,omitempty"`
+	// IdempotencyKey deduplicates redeliveries of the same event.
+	IdempotencyKey string            `json:"idempotency_key,omitempty"`
+	Attachments    []NewAttachment   `json:"attachments,omitempty"`
+	Headers        map[string]string `json:"headers,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	Category       string            `json:"category,omitempty"`
 }
 
 // CommandName implements cqrs.Command.
@@ -26,8 +38,8 @@ func (c SendCommand) CommandName() string {
 func (c SendCommand) Validate() error {
 	fields := make([]string, 0)
 
-	if c.Recipient == "" {
-		fields = append(fields, "recipient is required")
+	if c.Recipient == "" && len(c.To) == 0 {
+		fields = append(fields, "recipient or to is required")
 	}
 
 	if c.Template == "" {
@@ -45,4 +57,11 @@ func (c SendCommand) Validate() error {
 	return nil
 }
 
+// String prints the command with attachments reduced to their metadata, which
+// also keeps their bytes out of zap fields.
+func (c SendCommand) String() string {
+	type sendCommand SendCommand
+	return fmt.Sprintf("%+v", sendCommand(c))
+}
+
 var _ cqrs.Command = SendCommand{}
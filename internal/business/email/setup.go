@@ -1,6 +1,8 @@
 package email
 
 import (
+	"time"
+
 	"github.com/ose-micro/core/domain"
 	"github.com/ose-micro/core/timestamp"
 	"github.com/ose-micro/rid"
@@ -22,15 +24,34 @@ func (e emailDomain) Existing(param Params) (*Domain, error) {
 	aggregate := domain.ExistingAggregate(*id, version, createdAt, updatedAt, deletedAt, events)
 
 	return &Domain{
-		Aggregate: aggregate,
-		recipient: param.Recipient,
-		sender:    param.Sender,
-		from:      param.From,
-		subject:   param.Subject,
-		template:  param.Template,
-		data:      param.Data,
-		message:   param.Message,
-		state:     param.State,
+		Aggregate:       aggregate,
+		recipient:       param.Recipient,
+		to:              param.To,
+		cc:              param.Cc,
+		bcc:             param.Bcc,
+		replyTo:         param.ReplyTo,
+		sender:          param.Sender,
+		from:            param.From,
+		subject:         param.Subject,
+		template:        param.Template,
+		templateVersion: param.TemplateVersion,
+		data:            param.Data,
+		message:         param.Message,
+		text:            param.Text,
+		state:           param.State,
+		tries:           param.Tries,
+		attempts:        param.Attempts,
+		lastError:       param.LastError,
+		retryAt:         param.RetryAt,
+		sendAt:          param.SendAt,
+		idempotencyKey:  param.IdempotencyKey,
+		attachments:     param.Attachments,
+		headers:         param.Headers,
+		tags:            param.Tags,
+		messageId:       param.MessageId,
+		provider:        param.Provider,
+		category:        param.Category,
+		suppressed:      param.Suppressed,
 	}, nil
 }
 
@@ -41,15 +62,34 @@ func (e emailDomain) New(param Params) (*Domain, error) {
 	aggregate := domain.NewAggregate(*id)
 
 	return &Domain{
-		Aggregate: aggregate,
-		recipient: param.Recipient,
-		sender:    param.Sender,
-		from:      param.From,
-		subject:   param.Subject,
-		template:  param.Template,
-		data:      param.Data,
-		message:   param.Message,
-		state:     param.State,
+		Aggregate:       aggregate,
+		recipient:       param.Recipient,
+		to:              param.To,
+		cc:              param.Cc,
+		bcc:             param.Bcc,
+		replyTo:         param.ReplyTo,
+		sender:          param.Sender,
+		from:            param.From,
+		subject:         param.Subject,
+		template:        param.Template,
+		templateVersion: param.TemplateVersion,
+		data:            param.Data,
+		message:         param.Message,
+		text:            param.Text,
+		state:           param.State,
+		attempts:        param.Attempts,
+		lastError:       param.LastError,
+		retryAt:         param.RetryAt,
+		sendAt:          param.SendAt,
+		idempotencyKey:  param.IdempotencyKey,
+		attachments:     param.Attachments,
+		headers:         param.Headers,
+		tags:            param.Tags,
+		messageId:       param.MessageId,
+		provider:        param.Provider,
+		category:        param.Category,
+		suppressed:      param.Suppressed,
+		changes:         []Change{{To: param.State, At: time.Now()}},
 	}, nil
 }
 
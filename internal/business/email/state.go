@@ -0,0 +1,83 @@
+package email
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type State string
+
+const (
+	StateQueued     State = "Queued"
+	StateScheduled  State = "Scheduled"
+	StateSending    State = "Sending"
+	StateSent       State = "Sent"
+	StateDelivered  State = "Delivered"
+	StateBounced    State = "Bounced"
+	StateComplained State = "Complained"
+	StateCancelled  State = "Cancelled"
+	StateSuppressed State = "Suppressed"
+	StateFailed     State = "Failed"
+
+	// stateLegacySent is how sent emails were stored before the lifecycle existed.
+	stateLegacySent State = "Complement"
+)
+
+// Change is one move of an email between states. From is empty for the state
+// an email is created in.
+type Change struct {
+	From State
+	To   State
+	At   time.Time
+}
+
+// ErrInvalidTransition is returned when an email cannot move to the requested state.
+var ErrInvalidTransition = errors.New("invalid email state transition")
+
+// transitions lists, per state, the states an email may move to next.
+var transitions = map[State][]State{
+	StateScheduled:  {StateQueued, StateCancelled},
+	StateQueued:     {StateSending, StateScheduled, StateCancelled, StateSuppressed},
+	StateSending:    {StateSent, StateQueued, StateFailed, StateSuppressed},
+	StateSent:       {StateDelivered, StateBounced, StateComplained},
+	StateDelivered:  {StateBounced, StateComplained},
+	StateBounced:    {StateQueued, StateComplained},
+	StateFailed:     {StateQueued},
+	StateCancelled:  {StateQueued},
+	StateComplained: {},
+	StateSuppressed: {},
+}
+
+// Canonical maps values stored by earlier releases onto the current lifecycle.
+func (s State) Canonical() State {
+	if s == stateLegacySent {
+		return StateSent
+	}
+
+	return s
+}
+
+// CanTransition reports whether an email in s may move to next.
+func (s State) CanTransition(next State) bool {
+	for _, allowed := range transitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Transition moves the email to next, rejecting moves the lifecycle does not allow.
+func (d *Domain) Transition(next State) error {
+	if !d.state.CanTransition(next) {
+		return fmt.Errorf("%w: %s to %s", ErrInvalidTransition, d.state, next)
+	}
+
+	d.changes = append(d.changes, Change{From: d.state, To: next, At: time.Now()})
+	d.state = next
+	d.Touch()
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package event
+
+import (
+	"github.com/ose-micro/postman/internal/business/email"
+)
+
+// subjects maps the email states other services are told about to their subject.
+var subjects = map[email.State]string{
+	email.StateQueued:  EmailQueued,
+	email.StateSent:    EmailSent,
+	email.StateFailed:  EmailFailed,
+	email.StateBounced: EmailBounced,
+}
+
+// EmailSubject returns the subject published when an email enters state, and
+// false when that state is not published.
+func EmailSubject(state email.State) (string, bool) {
+	subject, ok := subjects[state]
+	return subject, ok
+}
+
+// EmailEvents returns an event for each of changes that is published.
+func EmailEvents(record email.Public, changes []email.Change) []Envelope {
+	events := make([]Envelope, 0, len(changes))
+	for _, change := range changes {
+		subject, ok := EmailSubject(change.To)
+		if !ok {
+			continue
+		}
+
+		events = append(events, New(subject, change.At, NewEmail(record, change)))
+	}
+
+	return events
+}
+
+// Email is the data of the postman.email.* events. The body and attachments
+// are left out; consumers that need them read the email by id. Bcc is never
+// published, so blind copies stay blind to every subscriber of the stream.
+type Email struct {
+	Id        string            `json:"id"`
+	From      email.State       `json:"from,omitempty"`
+	State     email.State       `json:"state"`
+	To        []string          `json:"to"`
+	Cc        []string          `json:"cc,omitempty"`
+	Sender    string            `json:"sender,omitempty"`
+	Subject   string            `json:"subject"`
+	Template  string            `json:"template,omitempty"`
+	Category  string            `json:"category,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	MessageId string            `json:"message_id,omitempty"`
+	Provider  string            `json:"provider,omitempty"`
+	Tries     int32             `json:"tries"`
+	LastError string            `json:"last_error,omitempty"`
+}
+
+// NewEmail describes change of record.
+func NewEmail(record email.Public, change email.Change) Email {
+	return Email{
+		Id:        record.Id,
+		From:      change.From,
+		State:     change.To,
+		To:        record.To,
+		Cc:        record.Cc,
+		Sender:    record.Sender,
+		Subject:   record.Subject,
+		Template:  record.Template,
+		Category:  record.Category,
+		Tags:      record.Tags,
+		MessageId: record.MessageId,
+		Provider:  record.Provider,
+		Tries:     record.Tries,
+		LastError: record.LastError,
+	}
+}
@@ -0,0 +1,57 @@
+package event
+
+import (
+	"time"
+
+	"github.com/ose-micro/rid"
+)
+
+// Stream is the NATS stream postman publishes its events onto.
+const Stream = "POSTMAN"
+
+// SchemaVersion is the version of the envelope and data layouts below. It is
+// bumped on any change a consumer could break on; fields are only ever added
+// within a version.
+const SchemaVersion = 1
+
+const (
+	EmailQueued     string = "postman.email.queued"
+	EmailSent       string = "postman.email.sent"
+	EmailFailed     string = "postman.email.failed"
+	EmailBounced    string = "postman.email.bounced"
+	TemplateCreated string = "postman.template.created"
+	TemplateUpdated string = "postman.template.updated"
+	TemplateDeleted string = "postman.template.deleted"
+)
+
+// Subjects lists every subject postman publishes to.
+func Subjects() []string {
+	return []string{
+		EmailQueued, EmailSent, EmailFailed, EmailBounced,
+		TemplateCreated, TemplateUpdated, TemplateDeleted,
+	}
+}
+
+// Envelope wraps the data of every event so consumers can dispatch on Type
+// and SchemaVersion before decoding Data.
+type Envelope struct {
+	// Id is unique per event; consumers use it to drop redeliveries.
+	Id            string    `json:"id"`
+	Type          string    `json:"type"`
+	SchemaVersion int       `json:"schema_version"`
+	Source        string    `json:"source"`
+	OccurredAt    time.Time `json:"occurred_at"`
+	Data          any       `json:"data"`
+}
+
+// New wraps data as an event of kind subject.
+func New(subject string, at time.Time, data any) Envelope {
+	return Envelope{
+		Id:            rid.New("evt", true).String(),
+		Type:          subject,
+		SchemaVersion: SchemaVersion,
+		Source:        "postman",
+		OccurredAt:    at,
+		Data:          data,
+	}
+}
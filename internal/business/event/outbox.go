@@ -0,0 +1,53 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Message is an event waiting in the outbox. It is written in the same
+// transaction as the change it describes and published by the relay, so a
+// crash between the two cannot lose it; it may be published more than once.
+type Message struct {
+	Id      string
+	Subject string
+	// Payload is the JSON encoded Envelope, published as is.
+	Payload      string
+	Attempts     int32
+	LastError    string
+	CreatedAt    time.Time
+	DispatchedAt *time.Time
+}
+
+// NewMessage encodes envelope for the outbox.
+func NewMessage(envelope Envelope) (Message, error) {
+	payload, err := json.Marshal(envelope)
+	if err != nil {
+		return Message{}, err
+	}
+
+	return Message{
+		Id:        envelope.Id,
+		Subject:   envelope.Type,
+		Payload:   string(payload),
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+type Outbox interface {
+	// Claim hides the oldest unleased undispatched message from other relays
+	// for lease and returns it, or nil when nothing is waiting. Messages still
+	// leased after a failed publish are passed over, so order is not kept.
+	Claim(ctx context.Context, lease time.Duration) (*Message, error)
+	// Dispatched marks the message with id published so it is never relayed again.
+	Dispatched(ctx context.Context, id string) error
+	// Failed records why publishing the message with id failed; it is relayed
+	// again once its lease runs out.
+	Failed(ctx context.Context, id, reason string) error
+}
+
+type App interface {
+	// Relay publishes one waiting message, returning nil when none is waiting.
+	Relay(ctx context.Context, command RelayCommand) (*Message, error)
+}
@@ -0,0 +1,30 @@
+package event
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ose-micro/cqrs"
+)
+
+// RelayCommand claims the oldest unleased undispatched outbox message for
+// Lease and publishes it.
+type RelayCommand struct {
+	Lease time.Duration
+}
+
+// CommandName implements cqrs.Command.
+func (r RelayCommand) CommandName() string {
+	return "postman.event.relay.command"
+}
+
+// Validate implements cqrs.Command.
+func (r RelayCommand) Validate() error {
+	if r.Lease <= 0 {
+		return fmt.Errorf("lease must be positive")
+	}
+
+	return nil
+}
+
+var _ cqrs.Command = RelayCommand{}
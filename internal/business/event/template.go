@@ -0,0 +1,26 @@
+package event
+
+import (
+	"github.com/ose-micro/postman/internal/business/template"
+)
+
+// Template is the data of the postman.template.* events. Version is the
+// revision the template head points at after the change.
+type Template struct {
+	Id           string   `json:"id"`
+	Key          string   `json:"key"`
+	Subject      string   `json:"subject"`
+	Placeholders []string `json:"placeholders,omitempty"`
+	Version      int32    `json:"version"`
+}
+
+// NewTemplate describes record after a change.
+func NewTemplate(record template.Public) Template {
+	return Template{
+		Id:           record.Id,
+		Key:          record.Key,
+		Subject:      record.Subject,
+		Placeholders: record.Placeholders,
+		Version:      record.Version,
+	}
+}
@@ -0,0 +1,48 @@
+package subscription
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ose-micro/cqrs"
+)
+
+type CreateCommand struct {
+	Url string
+	// Secret keys the callback signatures; one is generated when empty.
+	Secret string
+	// Filter lists event names such as email.delivered; empty subscribes to all.
+	Filter []string
+	Tenant string
+}
+
+// CommandName implements cqrs.Command.
+func (c CreateCommand) CommandName() string {
+	return "postman.subscription.create.command"
+}
+
+// Validate implements cqrs.Command.
+func (c CreateCommand) Validate() error {
+	fields := make([]string, 0)
+
+	if c.Url == "" {
+		fields = append(fields, "url is required")
+	} else if target, err := url.Parse(c.Url); err != nil || (target.Scheme != "https" && target.Scheme != "http") || target.Host == "" {
+		fields = append(fields, fmt.Sprintf("url %q must be an absolute http or https url", c.Url))
+	}
+
+	for _, event := range c.Filter {
+		if !ValidEvent(event) {
+			fields = append(fields, fmt.Sprintf("event %q is unknown", event))
+		}
+	}
+
+	if len(fields) > 0 {
+		return fmt.Errorf("%s", strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+var _ cqrs.Command = CreateCommand{}
@@ -0,0 +1,48 @@
+package subscription
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ose-micro/cqrs"
+	"github.com/ose-micro/postman/internal/business/email"
+)
+
+// DeliverCommand claims the next due callback for Lease and posts it, giving
+// the subscriber Timeout to answer and rescheduling failures according to Retry.
+type DeliverCommand struct {
+	Lease   time.Duration
+	Timeout time.Duration
+	Retry   email.RetryPolicy
+}
+
+// CommandName implements cqrs.Command.
+func (d DeliverCommand) CommandName() string {
+	return "postman.subscription.deliver.command"
+}
+
+// Validate implements cqrs.Command.
+func (d DeliverCommand) Validate() error {
+	fields := make([]string, 0)
+
+	if d.Lease <= 0 {
+		fields = append(fields, "lease must be positive")
+	}
+
+	if d.Timeout <= 0 || d.Timeout >= d.Lease {
+		fields = append(fields, "timeout must be positive and shorter than the lease")
+	}
+
+	if d.Retry.MaxAttempts <= 0 {
+		fields = append(fields, "max attempts must be positive")
+	}
+
+	if len(fields) > 0 {
+		return fmt.Errorf("%s", strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+var _ cqrs.Command = DeliverCommand{}
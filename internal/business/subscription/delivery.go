@@ -0,0 +1,77 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/ose-micro/rid"
+)
+
+// DeliveryState is where a callback is in being handed to its subscriber.
+type DeliveryState string
+
+const (
+	DeliveryPending   DeliveryState = "pending"
+	DeliveryDelivered DeliveryState = "delivered"
+	DeliveryFailed    DeliveryState = "failed"
+)
+
+// Delivery is one callback owed to a subscriber, kept as its delivery log.
+type Delivery struct {
+	Id             string `json:"_id"`
+	SubscriptionId string `json:"subscription_id"`
+	EmailId        string `json:"email_id"`
+	Event          string `json:"event"`
+	// Payload is the JSON body posted, fixed when the event happened.
+	Payload  string        `json:"payload"`
+	State    DeliveryState `json:"state"`
+	Attempts int32         `json:"attempts"`
+	// Status is the HTTP status of the last attempt; zero when no response came back.
+	Status        int32      `json:"status"`
+	LastError     string     `json:"last_error"`
+	NextAttemptAt *time.Time `json:"next_attempt_at"`
+	DeliveredAt   *time.Time `json:"delivered_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// NewDelivery starts a pending delivery of event to subscription, due now.
+func NewDelivery(subscriptionId, emailId, event string) Delivery {
+	now := time.Now()
+
+	return Delivery{
+		Id:             rid.New("whd", true).String(),
+		SubscriptionId: subscriptionId,
+		EmailId:        emailId,
+		Event:          event,
+		State:          DeliveryPending,
+		NextAttemptAt:  &now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// Succeeded records an attempt the subscriber acknowledged with status.
+func (d *Delivery) Succeeded(status int32) {
+	now := time.Now()
+
+	d.Attempts++
+	d.State = DeliveryDelivered
+	d.Status = status
+	d.LastError = ""
+	d.NextAttemptAt = nil
+	d.DeliveredAt = &now
+	d.UpdatedAt = now
+}
+
+// Failed records a failed attempt, retrying at retryAt or giving up when it is nil.
+func (d *Delivery) Failed(status int32, reason string, retryAt *time.Time) {
+	d.Attempts++
+	d.Status = status
+	d.LastError = reason
+	d.NextAttemptAt = retryAt
+	d.UpdatedAt = time.Now()
+
+	if retryAt == nil {
+		d.State = DeliveryFailed
+	}
+}
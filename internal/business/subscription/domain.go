@@ -0,0 +1,111 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/ose-micro/core/domain"
+	"github.com/ose-micro/rid"
+)
+
+// Domain is a callback URL that is told about email state changes, either
+// all of them or only the events it lists.
+type Domain struct {
+	*domain.Aggregate
+	url    string
+	secret string
+	filter []string
+	tenant string
+}
+
+type Public struct {
+	Id        string         `json:"_id"`
+	Url       string         `json:"url"`
+	Secret    string         `json:"secret"`
+	Filter    []string       `json:"filter"`
+	Tenant    string         `json:"tenant"`
+	Version   int32          `json:"version"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt *time.Time     `json:"deleted_at"`
+	Events    []domain.Event `json:"events"`
+}
+
+type Params struct {
+	*domain.Aggregate
+	Url    string
+	Secret string
+	Filter []string
+	Tenant string
+}
+
+func (p Public) Params() *Params {
+	id := rid.Existing(p.Id)
+	version := p.Version
+	createdAt := p.CreatedAt
+	updatedAt := p.UpdatedAt
+	deletedAt := p.DeletedAt
+	events := p.Events
+
+	aggregate := domain.ExistingAggregate(*id, version, createdAt, updatedAt, deletedAt, events)
+
+	return &Params{
+		Aggregate: aggregate,
+		Url:       p.Url,
+		Secret:    p.Secret,
+		Filter:    p.Filter,
+		Tenant:    p.Tenant,
+	}
+}
+
+func (d *Domain) Url() string {
+	return d.url
+}
+
+// Secret keys the HMAC signature of every callback.
+func (d *Domain) Secret() string {
+	return d.secret
+}
+
+// Filter lists the event names the subscriber wants; empty means all.
+func (d *Domain) Filter() []string {
+	return d.filter
+}
+
+// Tenant limits the subscription to emails tagged with that tenant; empty
+// receives every tenant's emails.
+func (d *Domain) Tenant() string {
+	return d.tenant
+}
+
+// Wants reports whether the subscriber should hear about event on an email of tenant.
+func (d *Domain) Wants(event, tenant string) bool {
+	if d.tenant != "" && d.tenant != tenant {
+		return false
+	}
+
+	if len(d.filter) == 0 {
+		return true
+	}
+
+	for _, name := range d.filter {
+		if name == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *Domain) Public() Public {
+	return Public{
+		Id:        d.ID(),
+		Url:       d.url,
+		Secret:    d.secret,
+		Filter:    d.filter,
+		Tenant:    d.tenant,
+		Version:   d.Version(),
+		CreatedAt: d.CreatedAt(),
+		UpdatedAt: d.UpdatedAt(),
+		DeletedAt: d.DeletedAt(),
+	}
+}
@@ -0,0 +1,40 @@
+package subscription
+
+import (
+	"strings"
+
+	"github.com/ose-micro/postman/internal/business/email"
+)
+
+// TenantTag is the email tag that names the tenant an email belongs to.
+const TenantTag = "tenant"
+
+var states = []email.State{
+	email.StateQueued,
+	email.StateScheduled,
+	email.StateSending,
+	email.StateSent,
+	email.StateDelivered,
+	email.StateBounced,
+	email.StateComplained,
+	email.StateCancelled,
+	email.StateSuppressed,
+	email.StateFailed,
+}
+
+// EventName is what subscribers filter on for an email entering state, e.g.
+// email.delivered.
+func EventName(state email.State) string {
+	return "email." + strings.ToLower(string(state))
+}
+
+// ValidEvent reports whether name is the event of some email state.
+func ValidEvent(name string) bool {
+	for _, state := range states {
+		if EventName(state) == name {
+			return true
+		}
+	}
+
+	return false
+}
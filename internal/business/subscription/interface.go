@@ -0,0 +1,47 @@
+package subscription
+
+import (
+	"context"
+	"time"
+
+	"github.com/ose-micro/core/dto"
+)
+
+type Repo interface {
+	Create(ctx context.Context, payload Domain) error
+	Read(ctx context.Context, request dto.Request) (map[string]any, error)
+	ReadOne(ctx context.Context, request dto.Request) (*Domain, error)
+	Delete(ctx context.Context, payload Domain) error
+	// Match returns the subscriptions of tenant, and those without one, that
+	// may want event. Callers still check Wants.
+	Match(ctx context.Context, event, tenant string) ([]Domain, error)
+	// ClaimNotification hides the oldest queued notification from other
+	// workers for lease and returns it, or nil when none is waiting.
+	ClaimNotification(ctx context.Context, lease time.Duration) (*Notification, error)
+	// Fanout queues deliveries and drops the notification with id they were
+	// made from in one transaction. It is a conflict when another worker got
+	// there first.
+	Fanout(ctx context.Context, id string, deliveries []Delivery) error
+	ReadDeliveries(ctx context.Context, request dto.Request) (map[string]any, error)
+	// ClaimDelivery hides the oldest due pending delivery from other workers
+	// for lease and returns it, or nil when nothing is due.
+	ClaimDelivery(ctx context.Context, lease time.Duration) (*Delivery, error)
+	UpdateDelivery(ctx context.Context, delivery Delivery) error
+	// Replay makes the delivery with id, or every failed delivery of
+	// subscriptionId, pending and due now, and reports how many it reset.
+	Replay(ctx context.Context, id, subscriptionId string) (int64, error)
+}
+
+type App interface {
+	// Create registers a subscription; its secret is only returned here.
+	Create(ctx context.Context, command CreateCommand) (*Domain, error)
+	Delete(ctx context.Context, command IdCommand) error
+	Read(ctx context.Context, request dto.Request) (map[string]any, error)
+	// Notify queues the callbacks owed for one notification, returning nil
+	// when none is waiting.
+	Notify(ctx context.Context, command NotifyCommand) (*Notification, error)
+	// Deliver posts one due callback, returning nil when none is waiting.
+	Deliver(ctx context.Context, command DeliverCommand) (*Delivery, error)
+	Deliveries(ctx context.Context, request dto.Request) (map[string]any, error)
+	Replay(ctx context.Context, command ReplayCommand) (int64, error)
+}
@@ -0,0 +1,28 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/rid"
+)
+
+// Notification is a batch of an email's state changes waiting to be turned
+// into deliveries. It is written in the same transaction as the changes, so
+// a crash right after the save cannot lose the callbacks they owe.
+type Notification struct {
+	Id        string
+	Email     PayloadEmail
+	Changes   []email.Change
+	CreatedAt time.Time
+}
+
+// NewNotification queues changes of record for the subscribers.
+func NewNotification(record email.Public, changes []email.Change) Notification {
+	return Notification{
+		Id:        rid.New("ntf", true).String(),
+		Email:     NewPayloadEmail(record),
+		Changes:   changes,
+		CreatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,30 @@
+package subscription
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ose-micro/cqrs"
+)
+
+// NotifyCommand claims the oldest queued Notification for Lease and queues a
+// callback to every subscriber of each of its changes.
+type NotifyCommand struct {
+	Lease time.Duration
+}
+
+// CommandName implements cqrs.Command.
+func (c NotifyCommand) CommandName() string {
+	return "postman.subscription.notify.command"
+}
+
+// Validate implements cqrs.Command.
+func (c NotifyCommand) Validate() error {
+	if c.Lease <= 0 {
+		return fmt.Errorf("lease must be positive")
+	}
+
+	return nil
+}
+
+var _ cqrs.Command = NotifyCommand{}
@@ -0,0 +1,63 @@
+package subscription
+
+import (
+	"time"
+
+	"github.com/ose-micro/postman/internal/business/email"
+)
+
+// Payload is the JSON body of a callback.
+type Payload struct {
+	// Id is the delivery id; a replayed callback carries the same one.
+	Id         string       `json:"id"`
+	Event      string       `json:"event"`
+	From       email.State  `json:"from,omitempty"`
+	To         email.State  `json:"to"`
+	OccurredAt time.Time    `json:"occurred_at"`
+	Email      PayloadEmail `json:"email"`
+}
+
+// PayloadEmail is the part of the email a subscriber needs to act on the
+// change; the body, attachments and Bcc recipients are left out.
+type PayloadEmail struct {
+	Id        string            `json:"id"`
+	To        []string          `json:"to"`
+	Cc        []string          `json:"cc,omitempty"`
+	Subject   string            `json:"subject"`
+	Template  string            `json:"template,omitempty"`
+	Category  string            `json:"category,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	MessageId string            `json:"message_id,omitempty"`
+	Provider  string            `json:"provider,omitempty"`
+	Tries     int32             `json:"tries"`
+	LastError string            `json:"last_error,omitempty"`
+}
+
+// NewPayloadEmail keeps the part of record a callback carries.
+func NewPayloadEmail(record email.Public) PayloadEmail {
+	return PayloadEmail{
+		Id:        record.Id,
+		To:        record.To,
+		Cc:        record.Cc,
+		Subject:   record.Subject,
+		Template:  record.Template,
+		Category:  record.Category,
+		Tags:      record.Tags,
+		MessageId: record.MessageId,
+		Provider:  record.Provider,
+		Tries:     record.Tries,
+		LastError: record.LastError,
+	}
+}
+
+// NewPayload describes change of record for the delivery with id.
+func NewPayload(id string, record PayloadEmail, change email.Change) Payload {
+	return Payload{
+		Id:         id,
+		Event:      EventName(change.To),
+		From:       change.From,
+		To:         change.To,
+		OccurredAt: change.At,
+		Email:      record,
+	}
+}
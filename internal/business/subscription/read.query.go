@@ -0,0 +1,30 @@
+package subscription
+
+import (
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/cqrs"
+)
+
+type ReadQuery struct {
+	Request dto.Request
+}
+
+// QueryName implements cqrs.Query.
+func (c ReadQuery) QueryName() string {
+	return "subscription.read.query"
+}
+
+// DeliveriesQuery reads the delivery log.
+type DeliveriesQuery struct {
+	Request dto.Request
+}
+
+// QueryName implements cqrs.Query.
+func (c DeliveriesQuery) QueryName() string {
+	return "subscription.deliveries.query"
+}
+
+var (
+	_ cqrs.Query = ReadQuery{}
+	_ cqrs.Query = DeliveriesQuery{}
+)
@@ -0,0 +1,37 @@
+package subscription
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ose-micro/cqrs"
+)
+
+// ReplayCommand queues callbacks again with a fresh attempt budget: the
+// delivery with Id, or every failed delivery of SubscriptionId.
+type ReplayCommand struct {
+	Id             string
+	SubscriptionId string
+}
+
+// CommandName implements cqrs.Command.
+func (c ReplayCommand) CommandName() string {
+	return "postman.subscription.replay.command"
+}
+
+// Validate implements cqrs.Command.
+func (c ReplayCommand) Validate() error {
+	fields := make([]string, 0)
+
+	if (c.Id == "") == (c.SubscriptionId == "") {
+		fields = append(fields, "exactly one of id or subscription id is required")
+	}
+
+	if len(fields) > 0 {
+		return fmt.Errorf("%s", strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+var _ cqrs.Command = ReplayCommand{}
@@ -0,0 +1,52 @@
+package subscription
+
+import (
+	"github.com/ose-micro/core/domain"
+	"github.com/ose-micro/core/timestamp"
+	"github.com/ose-micro/rid"
+)
+
+type subscriptionDomain struct {
+	timestamp timestamp.Timestamp
+}
+
+// Existing Load implements IDomain.
+func (s subscriptionDomain) Existing(param Params) (*Domain, error) {
+	id := rid.Existing(param.Aggregate.ID())
+	version := param.Aggregate.Version()
+	createdAt := param.Aggregate.CreatedAt()
+	updatedAt := param.Aggregate.UpdatedAt()
+	deletedAt := param.Aggregate.DeletedAt()
+	events := param.Aggregate.Events()
+
+	aggregate := domain.ExistingAggregate(*id, version, createdAt, updatedAt, deletedAt, events)
+
+	return &Domain{
+		Aggregate: aggregate,
+		url:       param.Url,
+		secret:    param.Secret,
+		filter:    param.Filter,
+		tenant:    param.Tenant,
+	}, nil
+}
+
+// New implements IDomain.
+func (s subscriptionDomain) New(param Params) (*Domain, error) {
+	id := rid.New("whs", true)
+
+	aggregate := domain.NewAggregate(*id)
+
+	return &Domain{
+		Aggregate: aggregate,
+		url:       param.Url,
+		secret:    param.Secret,
+		filter:    param.Filter,
+		tenant:    param.Tenant,
+	}, nil
+}
+
+func NewSubscriptionDomain(timestamp timestamp.Timestamp) domain.Domain[Domain, Params] {
+	return &subscriptionDomain{
+		timestamp: timestamp,
+	}
+}
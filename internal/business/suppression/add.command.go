@@ -0,0 +1,54 @@
+package suppression
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/ose-micro/cqrs"
+)
+
+type AddCommand struct {
+	Address string
+	Reason  Reason
+	// Source names what is adding the entry; api when empty.
+	Source string
+	// Category scopes the entry, e.g. marketing; empty suppresses all mail.
+	Category string
+	Note     string
+	// ExpiresAt lifts the suppression automatically; nil keeps it until removed.
+	ExpiresAt *time.Time
+}
+
+// CommandName implements cqrs.Command.
+func (c AddCommand) CommandName() string {
+	return "postman.suppression.add.command"
+}
+
+// Validate implements cqrs.Command.
+func (c AddCommand) Validate() error {
+	fields := make([]string, 0)
+
+	if c.Address == "" {
+		fields = append(fields, "address is required")
+	} else if _, err := mail.ParseAddress(c.Address); err != nil {
+		fields = append(fields, fmt.Sprintf("address %q is invalid", c.Address))
+	}
+
+	if !c.Reason.Valid() {
+		fields = append(fields, "reason must be one of bounce, complaint, unsubscribe or manual")
+	}
+
+	if c.ExpiresAt != nil && !c.ExpiresAt.After(time.Now()) {
+		fields = append(fields, "expires at must be in the future")
+	}
+
+	if len(fields) > 0 {
+		return fmt.Errorf("%s", strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+var _ cqrs.Command = AddCommand{}
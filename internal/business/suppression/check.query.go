@@ -0,0 +1,18 @@
+package suppression
+
+import (
+	"github.com/ose-micro/cqrs"
+)
+
+// CheckQuery asks which of Addresses may not receive an email of Category.
+type CheckQuery struct {
+	Addresses []string
+	Category  string
+}
+
+// QueryName implements cqrs.Query.
+func (c CheckQuery) QueryName() string {
+	return "suppression.check.query"
+}
+
+var _ cqrs.Query = CheckQuery{}
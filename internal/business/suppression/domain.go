@@ -0,0 +1,130 @@
+package suppression
+
+import (
+	"time"
+
+	"github.com/ose-micro/core/domain"
+	"github.com/ose-micro/rid"
+)
+
+// Domain is one address that must not be mailed, either at all or, when
+// category is set, only for emails of that category.
+type Domain struct {
+	*domain.Aggregate
+	address   string
+	reason    Reason
+	source    string
+	category  string
+	note      string
+	expiresAt *time.Time
+}
+
+type Public struct {
+	Id        string         `json:"_id"`
+	Address   string         `json:"address"`
+	Reason    Reason         `json:"reason"`
+	Source    string         `json:"source"`
+	Category  string         `json:"category"`
+	Note      string         `json:"note"`
+	ExpiresAt *time.Time     `json:"expires_at"`
+	Version   int32          `json:"version"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt *time.Time     `json:"deleted_at"`
+	Events    []domain.Event `json:"events"`
+}
+
+type Params struct {
+	*domain.Aggregate
+	Address   string
+	Reason    Reason
+	Source    string
+	Category  string
+	Note      string
+	ExpiresAt *time.Time
+}
+
+func (p Public) Params() *Params {
+	id := rid.Existing(p.Id)
+	version := p.Version
+	createdAt := p.CreatedAt
+	updatedAt := p.UpdatedAt
+	deletedAt := p.DeletedAt
+	events := p.Events
+
+	aggregate := domain.ExistingAggregate(*id, version, createdAt, updatedAt, deletedAt, events)
+
+	return &Params{
+		Aggregate: aggregate,
+		Address:   p.Address,
+		Reason:    p.Reason,
+		Source:    p.Source,
+		Category:  p.Category,
+		Note:      p.Note,
+		ExpiresAt: p.ExpiresAt,
+	}
+}
+
+func (d *Domain) Address() string {
+	return d.address
+}
+
+func (d *Domain) Reason() Reason {
+	return d.reason
+}
+
+// Source names what added the entry, such as api or bounce.
+func (d *Domain) Source() string {
+	return d.source
+}
+
+// Category limits the entry to emails of that category; empty applies to all.
+func (d *Domain) Category() string {
+	return d.category
+}
+
+func (d *Domain) Note() string {
+	return d.note
+}
+
+func (d *Domain) ExpiresAt() *time.Time {
+	return d.expiresAt
+}
+
+// Active reports whether the entry still suppresses at now.
+func (d *Domain) Active(now time.Time) bool {
+	return d.expiresAt == nil || d.expiresAt.After(now)
+}
+
+// Applies reports whether the entry blocks an email of category.
+func (d *Domain) Applies(category string) bool {
+	return d.category == "" || d.category == category
+}
+
+// Refresh replaces the reason, source, note and expiry of an existing entry,
+// used when the same address is suppressed again.
+func (d *Domain) Refresh(reason Reason, source, note string, expiresAt *time.Time) *Domain {
+	d.reason = reason
+	d.source = source
+	d.note = note
+	d.expiresAt = expiresAt
+	d.Touch()
+
+	return d
+}
+
+func (d *Domain) Public() Public {
+	return Public{
+		Id:        d.ID(),
+		Address:   d.address,
+		Reason:    d.reason,
+		Source:    d.source,
+		Category:  d.category,
+		Note:      d.note,
+		ExpiresAt: d.expiresAt,
+		Version:   d.Version(),
+		CreatedAt: d.CreatedAt(),
+		UpdatedAt: d.UpdatedAt(),
+		DeletedAt: d.DeletedAt(),
+	}
+}
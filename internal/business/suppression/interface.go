@@ -0,0 +1,26 @@
+package suppression
+
+import (
+	"context"
+	"time"
+
+	"github.com/ose-micro/core/dto"
+)
+
+type Repo interface {
+	Create(ctx context.Context, payload Domain) error
+	Read(ctx context.Context, request dto.Request) (map[string]any, error)
+	ReadOne(ctx context.Context, request dto.Request) (*Domain, error)
+	Update(ctx context.Context, payload Domain) error
+	Delete(ctx context.Context, payload Domain) error
+	// Check returns the entries active at at that block addresses for an email
+	// of category. Addresses must already be normalized.
+	Check(ctx context.Context, addresses []string, category string, at time.Time) ([]Domain, error)
+}
+
+type App interface {
+	Add(ctx context.Context, command AddCommand) (*Domain, error)
+	Remove(ctx context.Context, command RemoveCommand) error
+	Read(ctx context.Context, request dto.Request) (map[string]any, error)
+	Check(ctx context.Context, query CheckQuery) ([]Domain, error)
+}
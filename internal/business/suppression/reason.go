@@ -0,0 +1,34 @@
+package suppression
+
+import (
+	"net/mail"
+	"strings"
+)
+
+type Reason string
+
+const (
+	ReasonBounce      Reason = "bounce"
+	ReasonComplaint   Reason = "complaint"
+	ReasonUnsubscribe Reason = "unsubscribe"
+	ReasonManual      Reason = "manual"
+)
+
+func (r Reason) Valid() bool {
+	switch r {
+	case ReasonBounce, ReasonComplaint, ReasonUnsubscribe, ReasonManual:
+		return true
+	default:
+		return false
+	}
+}
+
+// Normalize reduces an RFC 5322 address, display name and all, to the bare
+// lower-cased mailbox that entries are keyed by.
+func Normalize(address string) string {
+	if parsed, err := mail.ParseAddress(address); err == nil {
+		address = parsed.Address
+	}
+
+	return strings.ToLower(strings.TrimSpace(address))
+}
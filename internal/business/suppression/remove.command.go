@@ -0,0 +1,37 @@
+package suppression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ose-micro/cqrs"
+)
+
+// RemoveCommand lifts a suppression, named either by Id or by Address and Category.
+type RemoveCommand struct {
+	Id       string
+	Address  string
+	Category string
+}
+
+// CommandName implements cqrs.Command.
+func (r RemoveCommand) CommandName() string {
+	return "postman.suppression.remove.command"
+}
+
+// Validate implements cqrs.Command.
+func (r RemoveCommand) Validate() error {
+	fields := make([]string, 0)
+
+	if r.Id == "" && r.Address == "" {
+		fields = append(fields, "id or address is required")
+	}
+
+	if len(fields) > 0 {
+		return fmt.Errorf("%s", strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+var _ cqrs.Command = RemoveCommand{}
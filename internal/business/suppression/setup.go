@@ -0,0 +1,56 @@
+package suppression
+
+import (
+	"github.com/ose-micro/core/domain"
+	"github.com/ose-micro/core/timestamp"
+	"github.com/ose-micro/rid"
+)
+
+type suppressionDomain struct {
+	timestamp timestamp.Timestamp
+}
+
+// Existing Load implements IDomain.
+func (s suppressionDomain) Existing(param Params) (*Domain, error) {
+	id := rid.Existing(param.Aggregate.ID())
+	version := param.Aggregate.Version()
+	createdAt := param.Aggregate.CreatedAt()
+	updatedAt := param.Aggregate.UpdatedAt()
+	deletedAt := param.Aggregate.DeletedAt()
+	events := param.Aggregate.Events()
+
+	aggregate := domain.ExistingAggregate(*id, version, createdAt, updatedAt, deletedAt, events)
+
+	return &Domain{
+		Aggregate: aggregate,
+		address:   param.Address,
+		reason:    param.Reason,
+		source:    param.Source,
+		category:  param.Category,
+		note:      param.Note,
+		expiresAt: param.ExpiresAt,
+	}, nil
+}
+
+// New implements IDomain.
+func (s suppressionDomain) New(param Params) (*Domain, error) {
+	id := rid.New("sup", true)
+
+	aggregate := domain.NewAggregate(*id)
+
+	return &Domain{
+		Aggregate: aggregate,
+		address:   Normalize(param.Address),
+		reason:    param.Reason,
+		source:    param.Source,
+		category:  param.Category,
+		note:      param.Note,
+		expiresAt: param.ExpiresAt,
+	}, nil
+}
+
+func NewSuppressionDomain(timestamp timestamp.Timestamp) domain.Domain[Domain, Params] {
+	return &suppressionDomain{
+		timestamp: timestamp,
+	}
+}
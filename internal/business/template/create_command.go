@@ -8,7 +8,9 @@ import (
 )
 
 type CreateCommand struct {
-	Content      string
+	Key          string
+	HtmlContent  string
+	TextContent  string
 	Subject      string
 	Placeholders []string
 }
@@ -22,14 +24,18 @@ func (c CreateCommand) CommandName() string {
 func (c CreateCommand) Validate() error {
 	fields := make([]string, 0)
 
-	if c.Content == "" {
-		fields = append(fields, "content is required")
+	if c.HtmlContent == "" {
+		fields = append(fields, "html content is required")
 	}
 
 	if c.Subject == "" {
 		fields = append(fields, "subject is required")
 	}
 
+	if c.Key != "" && !IsKey(c.Key) {
+		fields = append(fields, "key must be a lowercase slug")
+	}
+
 	if len(fields) > 0 {
 		return fmt.Errorf("%s", strings.Join(fields, ", "))
 	}
@@ -9,14 +9,20 @@ import (
 
 type Domain struct {
 	*domain.Aggregate
+	key          string
 	subject      string
-	content      string
+	htmlContent  string
+	textContent  string
 	placeholders []string
 }
 
 type Public struct {
-	Id           string         `json:"_id"`
+	Id string `json:"_id"`
+	// Content holds the HTML body of records written before html_content existed.
 	Content      string         `json:"content"`
+	Key          string         `json:"key"`
+	HtmlContent  string         `json:"html_content"`
+	TextContent  string         `json:"text_content"`
 	Subject      string         `json:"subject"`
 	Count        int32          `json:"count"`
 	Placeholders []string       `json:"placeholders"`
@@ -29,7 +35,9 @@ type Public struct {
 
 type Params struct {
 	*domain.Aggregate
-	Content      string
+	Key          string
+	HtmlContent  string
+	TextContent  string
 	Subject      string
 	Placeholders []string
 }
@@ -46,14 +54,43 @@ func (p Public) Params() *Params {
 
 	return &Params{
 		Aggregate:    aggregate,
+		Key:          p.Key,
 		Subject:      p.Subject,
-		Content:      p.Content,
+		HtmlContent:  p.Html(),
+		TextContent:  p.TextContent,
 		Placeholders: p.Placeholders,
 	}
 }
 
-func (d *Domain) Content() string {
-	return d.content
+// Html returns the HTML body, falling back to the legacy content field.
+func (p Public) Html() string {
+	if p.HtmlContent == "" {
+		return p.Content
+	}
+
+	return p.HtmlContent
+}
+
+func (d *Domain) Key() string {
+	return d.key
+}
+
+func (d *Domain) HtmlContent() string {
+	return d.htmlContent
+}
+
+// TextContent returns the plain-text body exactly as authored, which may be empty.
+func (d *Domain) TextContent() string {
+	return d.textContent
+}
+
+// Text returns the plain-text alternative, generated from the HTML body when none was authored.
+func (d *Domain) Text() string {
+	if d.textContent != "" {
+		return d.textContent
+	}
+
+	return PlainText(d.htmlContent)
 }
 
 func (d *Domain) Subject() string {
@@ -64,15 +101,31 @@ func (d *Domain) Placeholders() []string {
 	return d.placeholders
 }
 
-func (d *Domain) SetContent(content string) *Domain {
+// SetKey sets the key the template is looked up by. An update replaces the
+// whole template, so an empty key clears it.
+func (d *Domain) SetKey(key string) *Domain {
+	d.key = key
+	d.Touch()
+
+	return d
+}
+
+func (d *Domain) SetHtmlContent(content string) *Domain {
 	if content != "" {
-		d.content = content
+		d.htmlContent = content
 		d.Touch()
 	}
 
 	return d
 }
 
+func (d *Domain) SetTextContent(content string) *Domain {
+	d.textContent = content
+	d.Touch()
+
+	return d
+}
+
 func (d *Domain) SetPlaceholders(placeholders []string) *Domain {
 	d.placeholders = placeholders
 	d.Touch()
@@ -82,17 +135,46 @@ func (d *Domain) SetPlaceholders(placeholders []string) *Domain {
 
 func (d *Domain) SetSubject(subject string) *Domain {
 	if subject != "" {
-		d.content = subject
+		d.subject = subject
 		d.Touch()
 	}
 
 	return d
 }
 
+// Revise replaces the template body and bumps the aggregate version so the
+// previous revision stays addressable.
+func (d *Domain) Revise(html, text, subject string, placeholders []string) *Domain {
+	d.htmlContent = html
+	d.textContent = text
+	d.subject = subject
+	d.placeholders = placeholders
+
+	id := rid.Existing(d.ID())
+	d.Aggregate = domain.ExistingAggregate(*id, d.Version()+1, d.CreatedAt(), time.Now(), d.DeletedAt(), d.Events())
+
+	return d
+}
+
+func (d *Domain) Revision() Revision {
+	return Revision{
+		Id:           RevisionId(d.ID(), d.Version()),
+		Template:     d.ID(),
+		Version:      d.Version(),
+		Subject:      d.subject,
+		HtmlContent:  d.htmlContent,
+		TextContent:  d.textContent,
+		Placeholders: d.placeholders,
+		CreatedAt:    d.UpdatedAt(),
+	}
+}
+
 func (d *Domain) Public() Public {
 	return Public{
 		Id:           d.ID(),
-		Content:      d.content,
+		Key:          d.key,
+		HtmlContent:  d.htmlContent,
+		TextContent:  d.textContent,
 		Subject:      d.subject,
 		Placeholders: d.placeholders,
 		Version:      d.Version(),
@@ -6,12 +6,17 @@ import (
 	"github.com/ose-micro/core/dto"
 )
 
+// Repo stores templates together with their revision history. Create and
+// Update write the head and its revision atomically; Update fails with a
+// conflict unless the stored head is still at payload.Version()-1.
 type Repo interface {
 	Create(ctx context.Context, payload Domain) error
 	Read(ctx context.Context, request dto.Request) (map[string]any, error)
 	ReadOne(ctx context.Context, request dto.Request) (*Domain, error)
 	Update(ctx context.Context, payload Domain) error
 	Delete(ctx context.Context, payload Domain) error
+	ReadRevisions(ctx context.Context, request dto.Request) (map[string]any, error)
+	ReadOneRevision(ctx context.Context, request dto.Request) (*Revision, error)
 }
 
 type App interface {
@@ -19,4 +24,8 @@ type App interface {
 	Read(ctx context.Context, request dto.Request) (map[string]any, error)
 	Update(ctx context.Context, command UpdateCommand) error
 	Delete(ctx context.Context, command DeleteCommand) error
+	Revisions(ctx context.Context, query RevisionsQuery) ([]Revision, error)
+	Revision(ctx context.Context, query RevisionQuery) (*Revision, error)
+	Rollback(ctx context.Context, command RollbackCommand) (*Domain, error)
+	Render(ctx context.Context, query RenderQuery) (*Rendered, error)
 }
@@ -0,0 +1,53 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/ose-micro/core/dto"
+	ose_error "github.com/ose-micro/error"
+)
+
+var keyPattern = regexp.MustCompile(`^[a-z0-9]+(?:[._-][a-z0-9]+)*$`)
+
+// IsKey reports whether ref is shaped like a template key (e.g. "auth.welcome").
+// It only checks the format, a generated id can match it too, so use Lookup
+// to resolve a reference that may be either.
+func IsKey(ref string) bool {
+	return keyPattern.MatchString(ref)
+}
+
+// Lookup resolves ref as a template id first and, when no template has that
+// id, as a template key.
+func Lookup(ctx context.Context, repo Repo, ref string) (*Domain, error) {
+	record, err := repo.ReadOne(ctx, ByField("_id", ref))
+	if err == nil || !IsKey(ref) {
+		return record, err
+	}
+
+	var e *ose_error.Error
+	if !errors.As(err, &e) || e.Code != ose_error.ErrNotFound {
+		return nil, err
+	}
+
+	return repo.ReadOne(ctx, ByField("key", ref))
+}
+
+// ByField builds a request for the template whose field equals value.
+func ByField(field, value string) dto.Request {
+	return dto.Request{
+		Queries: []dto.Query{
+			{
+				Name: "one",
+				Filters: []dto.Filter{
+					{
+						Field: field,
+						Op:    dto.OpEq,
+						Value: value,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,49 @@
+package template
+
+import (
+	"regexp"
+	"sort"
+)
+
+var placeholderPattern = regexp.MustCompile(`{{\s*\.([a-zA-Z_][a-zA-Z0-9_]*)\s*}}`)
+
+// Placeholders returns the unique data keys referenced by the given template sources.
+func Placeholders(sources ...string) []string {
+	seen := make(map[string]bool)
+	keys := make([]string, 0)
+
+	for _, source := range sources {
+		for _, match := range placeholderPattern.FindAllStringSubmatch(source, -1) {
+			if !seen[match[1]] {
+				seen[match[1]] = true
+				keys = append(keys, match[1])
+			}
+		}
+	}
+
+	return keys
+}
+
+// Diff compares referenced placeholders against the supplied data and reports
+// keys the template needs but did not get, and keys that were supplied but never used.
+func Diff(placeholders []string, data map[string]interface{}) (missing []string, unused []string) {
+	referenced := make(map[string]bool, len(placeholders))
+	missing = make([]string, 0)
+	unused = make([]string, 0)
+
+	for _, key := range placeholders {
+		referenced[key] = true
+		if _, ok := data[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+
+	for key := range data {
+		if !referenced[key] {
+			unused = append(unused, key)
+		}
+	}
+	sort.Strings(unused)
+
+	return missing, unused
+}
@@ -0,0 +1,46 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ose-micro/cqrs"
+)
+
+type RenderQuery struct {
+	// Template is either the template id or its key. When empty, the given content and subject are rendered.
+	Template    string
+	HtmlContent string
+	TextContent string
+	Subject     string
+	Data        map[string]interface{}
+}
+
+// QueryName implements cqrs.Query.
+func (r RenderQuery) QueryName() string {
+	return "template.render.query"
+}
+
+func (r RenderQuery) Validate() error {
+	fields := make([]string, 0)
+
+	if r.Template == "" && r.HtmlContent == "" {
+		fields = append(fields, "template or content is required")
+	}
+
+	if len(fields) > 0 {
+		return fmt.Errorf("%s", strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+type Rendered struct {
+	Subject string
+	Html    string
+	Text    string
+	Missing []string
+	Unused  []string
+}
+
+var _ cqrs.Query = RenderQuery{}
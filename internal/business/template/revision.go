@@ -0,0 +1,22 @@
+package template
+
+import (
+	"fmt"
+	"time"
+)
+
+// Revision is an immutable snapshot of a template at a given aggregate version.
+type Revision struct {
+	Id           string    `json:"_id"`
+	Template     string    `json:"template"`
+	Version      int32     `json:"version"`
+	Subject      string    `json:"subject"`
+	HtmlContent  string    `json:"html_content"`
+	TextContent  string    `json:"text_content"`
+	Placeholders []string  `json:"placeholders"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func RevisionId(template string, version int32) string {
+	return fmt.Sprintf("%s:%d", template, version)
+}
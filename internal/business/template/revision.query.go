@@ -0,0 +1,60 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ose-micro/cqrs"
+)
+
+type RevisionQuery struct {
+	Id      string
+	Version int32
+}
+
+// QueryName implements cqrs.Query.
+func (r RevisionQuery) QueryName() string {
+	return "template.revision.query"
+}
+
+func (r RevisionQuery) Validate() error {
+	fields := make([]string, 0)
+
+	if r.Id == "" {
+		fields = append(fields, "id is required")
+	}
+
+	if r.Version <= 0 {
+		fields = append(fields, "version is required")
+	}
+
+	if len(fields) > 0 {
+		return fmt.Errorf("%s", strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+type RevisionsQuery struct {
+	Id    string
+	Skip  int64
+	Limit int64
+}
+
+// QueryName implements cqrs.Query.
+func (r RevisionsQuery) QueryName() string {
+	return "template.revisions.query"
+}
+
+func (r RevisionsQuery) Validate() error {
+	if r.Id == "" {
+		return fmt.Errorf("id is required")
+	}
+
+	return nil
+}
+
+var (
+	_ cqrs.Query = RevisionQuery{}
+	_ cqrs.Query = RevisionsQuery{}
+)
@@ -0,0 +1,39 @@
+package template
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ose-micro/cqrs"
+)
+
+type RollbackCommand struct {
+	Id      string
+	Version int32
+}
+
+// CommandName implements cqrs.Command.
+func (r RollbackCommand) CommandName() string {
+	return "postman.template.rollback.command"
+}
+
+// Validate implements cqrs.Command.
+func (r RollbackCommand) Validate() error {
+	fields := make([]string, 0)
+
+	if r.Id == "" {
+		fields = append(fields, "id is required")
+	}
+
+	if r.Version <= 0 {
+		fields = append(fields, "version is required")
+	}
+
+	if len(fields) > 0 {
+		return fmt.Errorf("%s", strings.Join(fields, ", "))
+	}
+
+	return nil
+}
+
+var _ cqrs.Command = RollbackCommand{}
@@ -23,8 +23,10 @@ func (r roleDomain) Existing(param Params) (*Domain, error) {
 
 	return &Domain{
 		Aggregate:    aggregate,
+		key:          param.Key,
 		subject:      param.Subject,
-		content:      param.Content,
+		htmlContent:  param.HtmlContent,
+		textContent:  param.TextContent,
 		placeholders: param.Placeholders,
 	}, nil
 }
@@ -37,8 +39,10 @@ func (r roleDomain) New(param Params) (*Domain, error) {
 
 	return &Domain{
 		Aggregate:    aggregate,
+		key:          param.Key,
 		subject:      param.Subject,
-		content:      param.Content,
+		htmlContent:  param.HtmlContent,
+		textContent:  param.TextContent,
 		placeholders: param.Placeholders,
 	}, nil
 }
@@ -0,0 +1,112 @@
+package template
+
+import (
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var (
+	spaces   = regexp.MustCompile(`[ \t\r\f\v]+`)
+	newlines = regexp.MustCompile(`\n{3,}`)
+)
+
+var blockTags = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "br": true,
+	"div": true, "dl": true, "dt": true, "dd": true, "footer": true, "form": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"header": true, "hr": true, "li": true, "main": true, "nav": true, "ol": true,
+	"p": true, "pre": true, "section": true, "table": true, "tr": true, "ul": true,
+}
+
+var skipTags = map[string]bool{
+	"head": true, "script": true, "style": true, "title": true,
+}
+
+// PlainText derives a readable text/plain alternative from an HTML body.
+// Template actions such as {{.name}} are kept as-is so the result can still be rendered.
+func PlainText(content string) string {
+	var out strings.Builder
+	var href []string
+	skip := 0
+
+	z := html.NewTokenizer(strings.NewReader(content))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			if z.Err() == io.EOF {
+				break
+			}
+			return strings.TrimSpace(content)
+		}
+
+		token := z.Token()
+		switch tt {
+		case html.TextToken:
+			if skip > 0 {
+				continue
+			}
+			out.WriteString(spaces.ReplaceAllString(strings.ReplaceAll(token.Data, "\n", " "), " "))
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if skipTags[token.Data] {
+				if tt == html.StartTagToken {
+					skip++
+				}
+				continue
+			}
+			if blockTags[token.Data] {
+				out.WriteString("\n")
+			}
+			switch token.Data {
+			case "li":
+				out.WriteString("- ")
+			case "hr":
+				out.WriteString("----------\n")
+			case "img":
+				if alt := attr(token, "alt"); alt != "" {
+					out.WriteString(alt)
+				}
+			case "a":
+				if tt == html.StartTagToken {
+					href = append(href, attr(token, "href"))
+				}
+			}
+		case html.EndTagToken:
+			if skipTags[token.Data] {
+				if skip > 0 {
+					skip--
+				}
+				continue
+			}
+			if token.Data == "a" && len(href) > 0 {
+				link := href[len(href)-1]
+				href = href[:len(href)-1]
+				if link != "" && !strings.HasPrefix(link, "#") && !strings.HasPrefix(link, "mailto:") {
+					out.WriteString(" (" + link + ")")
+				}
+			}
+			if blockTags[token.Data] && token.Data != "li" {
+				out.WriteString("\n")
+			}
+		}
+	}
+
+	lines := strings.Split(out.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	return strings.TrimSpace(newlines.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"))
+}
+
+func attr(token html.Token, key string) string {
+	for _, a := range token.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+
+	return ""
+}
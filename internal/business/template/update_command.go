@@ -9,7 +9,9 @@ import (
 
 type UpdateCommand struct {
 	Id           string
-	Content      string
+	Key          string
+	HtmlContent  string
+	TextContent  string
 	Subject      string
 	Placeholders []string
 }
@@ -27,14 +29,18 @@ func (u UpdateCommand) Validate() error {
 		fields = append(fields, "id is required")
 	}
 
-	if u.Content == "" {
-		fields = append(fields, "content is required")
+	if u.HtmlContent == "" {
+		fields = append(fields, "html content is required")
 	}
 
 	if u.Subject == "" {
 		fields = append(fields, "subject is required")
 	}
 
+	if u.Key != "" && !IsKey(u.Key) {
+		fields = append(fields, "key must be a lowercase slug")
+	}
+
 	if len(fields) > 0 {
 		return fmt.Errorf("%s", strings.Join(fields, ", "))
 	}
@@ -0,0 +1,81 @@
+package delivery
+
+import (
+	"sync"
+	"time"
+)
+
+// breaker is a per-provider circuit breaker. After threshold consecutive
+// transient failures it opens and the provider is skipped until cooldown has
+// passed; then a single probe is let through, closing the circuit on success
+// and reopening it on failure.
+type breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	open      bool
+	probing   bool
+	openedAt  time.Time
+}
+
+func (b *breaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.open {
+		return true
+	}
+
+	if b.probing || now.Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+
+	b.probing = true
+	return true
+}
+
+// retryIn tells how long until the breaker lets a probe through again.
+func (b *breaker) retryIn(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.open {
+		return 0
+	}
+
+	return max(b.cooldown-now.Sub(b.openedAt), 0)
+}
+
+// release gives back a probe that was allowed but never sent.
+func (b *breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+func (b *breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.open = false
+	b.probing = false
+}
+
+func (b *breaker) failure(now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.probing || b.failures >= b.threshold {
+		b.open = true
+		b.probing = false
+		b.openedAt = now
+	}
+}
+
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{threshold: threshold, cooldown: cooldown}
+}
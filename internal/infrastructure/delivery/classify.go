@@ -0,0 +1,75 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/textproto"
+)
+
+// StatusError is returned by HTTP transports when the provider rejects a message.
+type StatusError struct {
+	Status int
+	Body   string
+}
+
+func (s *StatusError) Error() string {
+	return fmt.Sprintf("provider responded %d: %s", s.Status, s.Body)
+}
+
+// IsPermanent reports whether err is a rejection that will not succeed on retry.
+// Per RFC 5321, 5yz SMTP replies are permanent and 4yz replies are transient.
+// HTTP 4xx responses are permanent except timeouts and rate limiting. Errors with
+// no reply code, such as dropped connections, are treated as transient. So are
+// authentication failures: they say the provider is misconfigured, not that the
+// message is bad, and another provider may well accept it.
+func IsPermanent(err error) bool {
+	if isProviderFault(err) {
+		return false
+	}
+
+	var reply *textproto.Error
+	if errors.As(err, &reply) {
+		return reply.Code >= 500 && reply.Code < 600
+	}
+
+	var status *StatusError
+	if errors.As(err, &status) {
+		return status.Status >= 400 && status.Status < 500 &&
+			status.Status != http.StatusRequestTimeout && status.Status != http.StatusTooManyRequests
+	}
+
+	return false
+}
+
+// isProviderFault reports whether err is the provider refusing our credentials
+// rather than the message: SMTP 530 (authentication required), 534 (mechanism
+// too weak) and 535 (credentials invalid), or HTTP 401 and 403.
+func isProviderFault(err error) bool {
+	var reply *textproto.Error
+	if errors.As(err, &reply) {
+		return reply.Code == 530 || reply.Code == 534 || reply.Code == 535
+	}
+
+	var status *StatusError
+	if errors.As(err, &status) {
+		return status.Status == http.StatusUnauthorized || status.Status == http.StatusForbidden
+	}
+
+	return false
+}
+
+// Reply extracts the SMTP reply code or HTTP status and its text from a failed send.
+func Reply(err error) (int, string) {
+	var reply *textproto.Error
+	if errors.As(err, &reply) {
+		return reply.Code, reply.Msg
+	}
+
+	var status *StatusError
+	if errors.As(err, &status) {
+		return status.Status, status.Body
+	}
+
+	return 0, ""
+}
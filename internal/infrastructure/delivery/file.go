@@ -0,0 +1,113 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// File writes each message into a maildir, which is handy for development and
+// for inspecting exactly what would have been sent.
+type File struct {
+	directory string
+	hostname  string
+	sequence  atomic.Uint64
+	log       logger.Logger
+	tracer    tracing.Tracer
+}
+
+// Name implements Transport.
+func (f *File) Name() string {
+	return TransportFile
+}
+
+// Send implements Transport.
+func (f *File) Send(ctx context.Context, message Message) error {
+	ctx, span := f.tracer.Start(ctx, "infrastructure.delivery.file.send", trace.WithAttributes(
+		attribute.String("operation", "send"),
+		attribute.StringSlice("to", message.To),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	path, err := f.write(message)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		f.log.Error("failed to write mail",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "send"),
+			zap.Error(err),
+		)
+
+		return err
+	}
+
+	f.log.Info("mail written successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "send"),
+		zap.String("path", path),
+	)
+
+	return nil
+}
+
+// write follows the maildir delivery protocol: the file is completed in tmp and
+// only then renamed into new, so readers never see a partial message.
+func (f *File) write(message Message) (string, error) {
+	body, err := message.Bytes()
+	if err != nil {
+		return "", err
+	}
+
+	name := fmt.Sprintf("%d.%d_%d.%s", time.Now().Unix(), os.Getpid(), f.sequence.Add(1), f.hostname)
+	tmp := filepath.Join(f.directory, "tmp", name)
+	if err := os.WriteFile(tmp, body, 0o600); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(f.directory, "new", name)
+	if err := os.Rename(tmp, path); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+func NewFile(conf Provider, log logger.Logger, tracer tracing.Tracer) (*File, error) {
+	if conf.Directory == "" {
+		return nil, errors.New("file transport requires a directory")
+	}
+
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		if err := os.MkdirAll(filepath.Join(conf.Directory, sub), 0o700); err != nil {
+			return nil, err
+		}
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+
+	return &File{
+		directory: conf.Directory,
+		hostname:  hostname,
+		log:       log,
+		tracer:    tracer,
+	}, nil
+}
+
+var _ Transport = (*File)(nil)
@@ -0,0 +1,224 @@
+package delivery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/mail"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// HTTP posts messages as JSON to a provider API in the style of SendGrid,
+// Mailgun or SES, authenticating with a bearer token.
+type HTTP struct {
+	endpoint string
+	apiKey   string
+	client   *http.Client
+	log      logger.Logger
+	tracer   tracing.Tracer
+}
+
+type httpAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type httpAttachment struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	// Content is base64 encoded by encoding/json.
+	Content   []byte `json:"content"`
+	ContentId string `json:"content_id,omitempty"`
+	Inline    bool   `json:"inline,omitempty"`
+}
+
+type httpPayload struct {
+	MessageId   string            `json:"message_id,omitempty"`
+	From        httpAddress       `json:"from"`
+	To          []httpAddress     `json:"to"`
+	Cc          []httpAddress     `json:"cc,omitempty"`
+	Bcc         []httpAddress     `json:"bcc,omitempty"`
+	ReplyTo     *httpAddress      `json:"reply_to,omitempty"`
+	Subject     string            `json:"subject"`
+	Html        string            `json:"html"`
+	Text        string            `json:"text"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Attachments []httpAttachment  `json:"attachments,omitempty"`
+}
+
+// Name implements Transport.
+func (h *HTTP) Name() string {
+	return TransportHTTP
+}
+
+// Send implements Transport.
+func (h *HTTP) Send(ctx context.Context, message Message) error {
+	_, err := h.Submit(ctx, message)
+	return err
+}
+
+// Submit implements Submitter, returning the id the provider gave the message.
+func (h *HTTP) Submit(ctx context.Context, message Message) (string, error) {
+	ctx, span := h.tracer.Start(ctx, "infrastructure.delivery.http.send", trace.WithAttributes(
+		attribute.String("operation", "send"),
+		attribute.StringSlice("to", message.To),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	providerId, err := h.post(ctx, message)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		h.log.Error("failed to send mail",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "send"),
+			zap.Error(err),
+		)
+
+		return "", err
+	}
+
+	h.log.Info("mail sent successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "send"),
+		zap.String("provider_id", providerId),
+	)
+
+	return providerId, nil
+}
+
+func (h *HTTP) post(ctx context.Context, message Message) (string, error) {
+	payload, err := newHTTPPayload(message)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, h.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if h.apiKey != "" {
+		request.Header.Set("Authorization", "Bearer "+h.apiKey)
+	}
+
+	response, err := h.client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	text, _ := io.ReadAll(io.LimitReader(response.Body, 4<<10))
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return "", &StatusError{Status: response.StatusCode, Body: string(text)}
+	}
+
+	// providers answer with their own message id under one of these names
+	var accepted struct {
+		Id        string `json:"id"`
+		MessageId string `json:"message_id"`
+	}
+	if json.Unmarshal(text, &accepted) == nil && accepted.Id != "" {
+		return accepted.Id, nil
+	}
+
+	return accepted.MessageId, nil
+}
+
+func newHTTPPayload(message Message) (httpPayload, error) {
+	to, err := httpAddresses(message.To)
+	if err != nil {
+		return httpPayload{}, err
+	}
+
+	cc, err := httpAddresses(message.Cc)
+	if err != nil {
+		return httpPayload{}, err
+	}
+
+	bcc, err := httpAddresses(message.Bcc)
+	if err != nil {
+		return httpPayload{}, err
+	}
+
+	payload := httpPayload{
+		MessageId: message.MessageId,
+		From:      httpAddress{Email: message.Sender, Name: message.From},
+		To:        to,
+		Cc:        cc,
+		Bcc:       bcc,
+		Subject:   message.Subject,
+		Html:      message.Html,
+		Text:      message.Text,
+		Headers:   message.Headers,
+	}
+
+	if message.ReplyTo != "" {
+		replyTo, err := httpAddresses([]string{message.ReplyTo})
+		if err != nil {
+			return httpPayload{}, err
+		}
+		payload.ReplyTo = &replyTo[0]
+	}
+
+	for _, attachment := range message.Attachments {
+		payload.Attachments = append(payload.Attachments, httpAttachment(attachment))
+	}
+
+	return payload, nil
+}
+
+func httpAddresses(list []string) ([]httpAddress, error) {
+	addresses := make([]httpAddress, 0, len(list))
+	for _, entry := range list {
+		address, err := mail.ParseAddress(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		addresses = append(addresses, httpAddress{Email: address.Address, Name: address.Name})
+	}
+
+	return addresses, nil
+}
+
+func NewHTTP(conf Provider, log logger.Logger, tracer tracing.Tracer) (*HTTP, error) {
+	if conf.Endpoint == "" {
+		return nil, errors.New("http transport requires an endpoint")
+	}
+
+	timeout := conf.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	return &HTTP{
+		endpoint: conf.Endpoint,
+		apiKey:   conf.ApiKey,
+		client:   &http.Client{Timeout: timeout},
+		log:      log,
+		tracer:   tracer,
+	}, nil
+}
+
+var (
+	_ Transport = (*HTTP)(nil)
+	_ Submitter = (*HTTP)(nil)
+)
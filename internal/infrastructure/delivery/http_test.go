@@ -0,0 +1,154 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPPost(t *testing.T) {
+	message := Message{
+		MessageId: "<1@example.com>",
+		Sender:    "noreply@example.com",
+		From:      "Example",
+		To:        []string{"Jane Doe <jane@example.com>"},
+		Cc:        []string{"copy@example.com"},
+		ReplyTo:   "support@example.com",
+		Subject:   "Welcome",
+		Html:      "<p>Hello</p>",
+		Text:      "Hello",
+		Headers:   map[string]string{"List-Id": "news.example.com"},
+		Attachments: []Attachment{{
+			Filename:    "a.txt",
+			ContentType: "text/plain",
+			Content:     []byte("attached"),
+		}},
+	}
+
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		providerId string
+		permanent  bool
+		failed     bool
+	}{
+		{name: "id", status: http.StatusOK, body: `{"id":"p-1"}`, providerId: "p-1"},
+		{name: "message id", status: http.StatusAccepted, body: `{"message_id":"p-2"}`, providerId: "p-2"},
+		{name: "no body", status: http.StatusNoContent},
+		{name: "rejected", status: http.StatusBadRequest, body: "bad sender", failed: true, permanent: true},
+		{name: "unauthorized", status: http.StatusUnauthorized, body: "invalid api key", failed: true},
+		{name: "rate limited", status: http.StatusTooManyRequests, body: "slow down", failed: true},
+		{name: "server error", status: http.StatusBadGateway, body: "upstream", failed: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var received httpPayload
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("method = %s, want POST", r.Method)
+				}
+				if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+					t.Errorf("authorization = %q", got)
+				}
+				if got := r.Header.Get("Content-Type"); got != "application/json" {
+					t.Errorf("content type = %q", got)
+				}
+
+				body, _ := io.ReadAll(r.Body)
+				if err := json.Unmarshal(body, &received); err != nil {
+					t.Errorf("decode payload: %v", err)
+				}
+
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			transport := &HTTP{endpoint: server.URL, apiKey: "secret", client: server.Client()}
+			providerId, err := transport.post(context.Background(), message)
+
+			if test.failed {
+				var status *StatusError
+				if !errors.As(err, &status) {
+					t.Fatalf("err = %v, want a StatusError", err)
+				}
+				if status.Status != test.status || status.Body != test.body {
+					t.Errorf("status = %d %q, want %d %q", status.Status, status.Body, test.status, test.body)
+				}
+				if IsPermanent(err) != test.permanent {
+					t.Errorf("permanent = %v, want %v", IsPermanent(err), test.permanent)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("post: %v", err)
+			}
+			if providerId != test.providerId {
+				t.Errorf("provider id = %q, want %q", providerId, test.providerId)
+			}
+
+			if received.MessageId != message.MessageId {
+				t.Errorf("message id = %q", received.MessageId)
+			}
+			if received.From != (httpAddress{Email: "noreply@example.com", Name: "Example"}) {
+				t.Errorf("from = %+v", received.From)
+			}
+			if len(received.To) != 1 || received.To[0] != (httpAddress{Email: "jane@example.com", Name: "Jane Doe"}) {
+				t.Errorf("to = %+v", received.To)
+			}
+			if len(received.Cc) != 1 || received.Cc[0].Email != "copy@example.com" {
+				t.Errorf("cc = %+v", received.Cc)
+			}
+			if received.ReplyTo == nil || received.ReplyTo.Email != "support@example.com" {
+				t.Errorf("reply to = %+v", received.ReplyTo)
+			}
+			if received.Subject != "Welcome" || received.Html != "<p>Hello</p>" || received.Text != "Hello" {
+				t.Errorf("content = %q %q %q", received.Subject, received.Html, received.Text)
+			}
+			if received.Headers["List-Id"] != "news.example.com" {
+				t.Errorf("headers = %v", received.Headers)
+			}
+			if len(received.Attachments) != 1 || string(received.Attachments[0].Content) != "attached" {
+				t.Errorf("attachments = %+v", received.Attachments)
+			}
+		})
+	}
+}
+
+func TestHTTPPostInvalidRecipient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent for an invalid recipient")
+	}))
+	defer server.Close()
+
+	transport := &HTTP{endpoint: server.URL, client: server.Client()}
+	if _, err := transport.post(context.Background(), Message{To: []string{"not an address"}}); err == nil {
+		t.Fatal("post succeeded for an invalid recipient")
+	}
+}
+
+func TestHTTPPostCancelled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	transport := &HTTP{endpoint: server.URL, client: server.Client()}
+	_, err := transport.post(ctx, Message{To: []string{"jane@example.com"}})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if IsPermanent(err) {
+		t.Error("a cancelled send must be retried")
+	}
+}
@@ -0,0 +1,259 @@
+package delivery
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Message is a fully rendered email ready to be handed to a transport.
+type Message struct {
+	// MessageId is the complete Message-ID header value, angle brackets included.
+	MessageId string
+	Sender    string
+	From      string
+	To        []string
+	Cc        []string
+	// Bcc only reaches the SMTP envelope, never the headers.
+	Bcc     []string
+	ReplyTo string
+	Subject string
+	Html    string
+	Text    string
+
+	// Headers are written after the standard ones; callers keep them clear of those.
+	Headers     map[string]string
+	Attachments []Attachment
+}
+
+// Attachment is a file carried by a Message. Inline attachments are referenced
+// from the HTML body as cid:ContentId.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+	ContentId   string
+	Inline      bool
+}
+
+// Bytes encodes the message as RFC 5322. The text and HTML bodies form a
+// multipart/alternative, plain text first so clients that prefer HTML pick the
+// last part; inline images wrap it in multipart/related and other attachments
+// in multipart/mixed.
+func (m Message) Bytes() ([]byte, error) {
+	from := (&mail.Address{Name: m.From, Address: m.Sender}).String()
+
+	inline := make([]Attachment, 0)
+	attached := make([]Attachment, 0)
+	for _, attachment := range m.Attachments {
+		if attachment.Inline {
+			inline = append(inline, attachment)
+		} else {
+			attached = append(attached, attachment)
+		}
+	}
+
+	body, contentType, err := alternative(m.Text, m.Html)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(inline) > 0 {
+		if body, contentType, err = enclose("related", body, contentType, inline); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(attached) > 0 {
+		if body, contentType, err = enclose("mixed", body, contentType, attached); err != nil {
+			return nil, err
+		}
+	}
+
+	replyTo := from
+	if m.ReplyTo != "" {
+		replyTo = addressList([]string{m.ReplyTo})
+	}
+
+	headers := [][2]string{
+		{"From", from},
+		{"Reply-To", replyTo},
+		{"To", addressList(m.To)},
+	}
+	if len(m.Cc) > 0 {
+		headers = append(headers, [2]string{"Cc", addressList(m.Cc)})
+	}
+	if m.MessageId != "" {
+		headers = append(headers, [2]string{"Message-ID", m.MessageId})
+	}
+
+	names := make([]string, 0, len(m.Headers))
+	for name := range m.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		headers = append(headers, [2]string{name, mime.QEncoding.Encode("utf-8", m.Headers[name])})
+	}
+	headers = append(headers, [][2]string{
+		{"Subject", mime.QEncoding.Encode("utf-8", m.Subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", contentType},
+	}...)
+
+	var msg bytes.Buffer
+	for _, header := range headers {
+		fmt.Fprintf(&msg, "%s: %s\r\n", header[0], header[1])
+	}
+	msg.WriteString("\r\n")
+	msg.Write(body)
+
+	return msg.Bytes(), nil
+}
+
+func alternative(text, html string) ([]byte, string, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	if err := writePart(writer, "text/plain", text); err != nil {
+		return nil, "", err
+	}
+
+	if err := writePart(writer, "text/html", html); err != nil {
+		return nil, "", err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return buf.Bytes(), multipartType("alternative", writer), nil
+}
+
+// enclose places an already encoded body first in a new multipart of the given
+// subtype, followed by the attachments.
+func enclose(subtype string, body []byte, contentType string, attachments []Attachment) ([]byte, string, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	part, err := writer.CreatePart(textproto.MIMEHeader{"Content-Type": {contentType}})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if _, err := part.Write(body); err != nil {
+		return nil, "", err
+	}
+
+	for _, attachment := range attachments {
+		if err := writeAttachment(writer, attachment); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return buf.Bytes(), multipartType(subtype, writer), nil
+}
+
+func multipartType(subtype string, writer *multipart.Writer) string {
+	return mime.FormatMediaType("multipart/"+subtype, map[string]string{"boundary": writer.Boundary()})
+}
+
+func writeAttachment(writer *multipart.Writer, attachment Attachment) error {
+	contentType := attachment.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	disposition := "attachment"
+	if attachment.Inline {
+		disposition = "inline"
+	}
+
+	header := textproto.MIMEHeader{
+		"Content-Type":              {mime.FormatMediaType(contentType, map[string]string{"name": attachment.Filename})},
+		"Content-Disposition":       {mime.FormatMediaType(disposition, map[string]string{"filename": attachment.Filename})},
+		"Content-Transfer-Encoding": {"base64"},
+	}
+	if attachment.ContentId != "" {
+		header.Set("Content-ID", "<"+attachment.ContentId+">")
+	}
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	// RFC 2045 limits encoded lines to 76 characters
+	encoded := base64.StdEncoding.EncodeToString(attachment.Content)
+	for len(encoded) > 76 {
+		if _, err := io.WriteString(part, encoded[:76]+"\r\n"); err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+
+	_, err = io.WriteString(part, encoded+"\r\n")
+	return err
+}
+
+// Recipients returns the bare envelope addresses of every To, Cc and Bcc entry.
+func (m Message) Recipients() ([]string, error) {
+	recipients := make([]string, 0, len(m.To)+len(m.Cc)+len(m.Bcc))
+	for _, list := range [][]string{m.To, m.Cc, m.Bcc} {
+		for _, entry := range list {
+			address, err := mail.ParseAddress(entry)
+			if err != nil {
+				return nil, err
+			}
+
+			recipients = append(recipients, address.Address)
+		}
+	}
+
+	return recipients, nil
+}
+
+// addressList formats addresses for a header, encoding non-ASCII display names.
+func addressList(list []string) string {
+	formatted := make([]string, 0, len(list))
+	for _, entry := range list {
+		if address, err := mail.ParseAddress(entry); err == nil {
+			entry = address.String()
+		}
+
+		formatted = append(formatted, entry)
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+func writePart(writer *multipart.Writer, contentType, body string) error {
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType + `; charset="UTF-8"`},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+
+	qp := quotedprintable.NewWriter(part)
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return err
+	}
+
+	return qp.Close()
+}
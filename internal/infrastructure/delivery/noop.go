@@ -0,0 +1,36 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/ose-micro/core/logger"
+	"go.uber.org/zap"
+)
+
+// Noop accepts every message without sending it, logging what would have gone out.
+type Noop struct {
+	log logger.Logger
+}
+
+// Name implements Transport.
+func (n *Noop) Name() string {
+	return TransportNoop
+}
+
+// Send implements Transport.
+func (n *Noop) Send(_ context.Context, message Message) error {
+	n.log.Info("mail discarded by noop transport",
+		zap.String("operation", "send"),
+		zap.String("message_id", message.MessageId),
+		zap.Strings("to", message.To),
+		zap.String("subject", message.Subject),
+	)
+
+	return nil
+}
+
+func NewNoop(log logger.Logger) *Noop {
+	return &Noop{log: log}
+}
+
+var _ Transport = (*Noop)(nil)
@@ -0,0 +1,194 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/postman/internal/infrastructure/ratelimit"
+	"go.uber.org/zap"
+)
+
+// ErrUnavailable is matched by the UnavailableError returned when every
+// provider's circuit is open.
+var ErrUnavailable = errors.New("no delivery provider available")
+
+// UnavailableError reports that no provider could be tried and when the first
+// circuit lets a probe through again. Nothing was sent, so it is not an attempt.
+type UnavailableError struct {
+	Wait time.Duration
+}
+
+func (u *UnavailableError) Error() string {
+	return fmt.Sprintf("%s for %s", ErrUnavailable, u.Wait)
+}
+
+func (u *UnavailableError) Is(target error) bool {
+	return target == ErrUnavailable
+}
+
+// Router spreads messages over several providers. Providers are tried in
+// ascending priority; those sharing a priority are ordered by weighted random
+// choice. A transient failure fails over to the next provider, while a
+// permanent rejection is returned at once since another provider would refuse
+// the message too. An authentication failure counts against the provider's
+// breaker and fails over like any transient one. Providers over their rate limit are passed over, and when all
+// of them are, a ratelimit.ThrottledError is returned.
+type Router struct {
+	routes  []*route
+	limiter *ratelimit.Limiter
+	log     logger.Logger
+}
+
+type route struct {
+	name      string
+	priority  int
+	weight    int
+	transport Transport
+	breaker   *breaker
+}
+
+// Name implements Transport.
+func (r *Router) Name() string {
+	return "router"
+}
+
+// Send implements Transport.
+func (r *Router) Send(ctx context.Context, message Message) error {
+	_, err := r.Deliver(ctx, message)
+	return err
+}
+
+// Deliver sends message and reports the provider that accepted it, or the
+// last one tried when every attempt failed.
+func (r *Router) Deliver(ctx context.Context, message Message) (Receipt, error) {
+	var receipt Receipt
+	var err error
+	var throttled *ratelimit.ThrottledError
+	unavailable := &UnavailableError{}
+	for _, route := range r.order() {
+		if !route.breaker.allow(time.Now()) {
+			if wait := route.breaker.retryIn(time.Now()); unavailable.Wait == 0 || wait < unavailable.Wait {
+				unavailable.Wait = wait
+			}
+			continue
+		}
+
+		if limit := r.limiter.Take(ratelimit.Provider(route.name)); limit != nil {
+			// hand back the probe slot we may have just taken
+			route.breaker.release()
+			if wait, ok := limit.(*ratelimit.ThrottledError); ok && (throttled == nil || wait.Wait < throttled.Wait) {
+				throttled = wait
+			}
+			continue
+		}
+
+		receipt = Receipt{Provider: route.name}
+		receipt.ProviderId, err = submit(ctx, route.transport, message)
+		if err == nil {
+			route.breaker.success()
+			return receipt, nil
+		}
+
+		if IsPermanent(err) {
+			// The provider is healthy, it just refused this message.
+			route.breaker.success()
+			return receipt, err
+		}
+
+		route.breaker.failure(time.Now())
+		r.log.Error("delivery provider failed, failing over",
+			zap.String("operation", "send"),
+			zap.String("provider", route.name),
+			zap.Error(err),
+		)
+
+		if ctx.Err() != nil {
+			return receipt, err
+		}
+	}
+
+	if receipt.Provider == "" {
+		if throttled != nil {
+			return receipt, throttled
+		}
+
+		return receipt, unavailable
+	}
+
+	return receipt, err
+}
+
+// order returns the routes by priority, shuffling each priority band by weight.
+func (r *Router) order() []*route {
+	ordered := make([]*route, 0, len(r.routes))
+	for start := 0; start < len(r.routes); {
+		end := start
+		for end < len(r.routes) && r.routes[end].priority == r.routes[start].priority {
+			end++
+		}
+
+		ordered = append(ordered, weighted(r.routes[start:end])...)
+		start = end
+	}
+
+	return ordered
+}
+
+func weighted(band []*route) []*route {
+	pool := append([]*route(nil), band...)
+	ordered := make([]*route, 0, len(pool))
+	for len(pool) > 0 {
+		total := 0
+		for _, route := range pool {
+			total += route.weight
+		}
+
+		pick, n := 0, rand.IntN(total)
+		for i, route := range pool {
+			if n < route.weight {
+				pick = i
+				break
+			}
+			n -= route.weight
+		}
+
+		ordered = append(ordered, pool[pick])
+		pool = append(pool[:pick], pool[pick+1:]...)
+	}
+
+	return ordered
+}
+
+// Deliver sends message through transport and names the provider used. A
+// Router reports the provider it picked; any other transport reports itself.
+func Deliver(ctx context.Context, transport Transport, message Message) (Receipt, error) {
+	if router, ok := transport.(*Router); ok {
+		return router.Deliver(ctx, message)
+	}
+
+	providerId, err := submit(ctx, transport, message)
+	return Receipt{Provider: transport.Name(), ProviderId: providerId}, err
+}
+
+func submit(ctx context.Context, transport Transport, message Message) (string, error) {
+	if submitter, ok := transport.(Submitter); ok {
+		return submitter.Submit(ctx, message)
+	}
+
+	return "", transport.Send(ctx, message)
+}
+
+func newRouter(routes []*route, limiter *ratelimit.Limiter, log logger.Logger) *Router {
+	sort.SliceStable(routes, func(i, j int) bool {
+		return routes[i].priority < routes[j].priority
+	})
+
+	return &Router{routes: routes, limiter: limiter, log: log}
+}
+
+var _ Transport = (*Router)(nil)
@@ -0,0 +1,94 @@
+package delivery
+
+import (
+	"context"
+	"net/http"
+	"net/textproto"
+	"testing"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/postman/internal/infrastructure/ratelimit"
+)
+
+type stubTransport struct {
+	name string
+	err  error
+	sent int
+}
+
+func (s *stubTransport) Name() string {
+	return s.name
+}
+
+func (s *stubTransport) Send(context.Context, Message) error {
+	s.sent++
+	return s.err
+}
+
+type quietLogger struct {
+	logger.Logger
+}
+
+func (quietLogger) Info(string, ...any)  {}
+func (quietLogger) Error(string, ...any) {}
+func (quietLogger) Warn(string, ...any)  {}
+func (quietLogger) Debug(string, ...any) {}
+
+func TestRouterDeliver(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		failover bool
+	}{
+		{name: "smtp auth required", err: &textproto.Error{Code: 530, Msg: "5.7.0 Authentication required"}, failover: true},
+		{name: "smtp mechanism too weak", err: &textproto.Error{Code: 534, Msg: "5.7.9 Authentication mechanism is too weak"}, failover: true},
+		{name: "smtp bad credentials", err: &textproto.Error{Code: 535, Msg: "5.7.8 Authentication credentials invalid"}, failover: true},
+		{name: "http unauthorized", err: &StatusError{Status: http.StatusUnauthorized, Body: "invalid api key"}, failover: true},
+		{name: "http forbidden", err: &StatusError{Status: http.StatusForbidden, Body: "sender not allowed"}, failover: true},
+		{name: "smtp transient", err: &textproto.Error{Code: 421, Msg: "4.3.2 Service not available"}, failover: true},
+		{name: "http server error", err: &StatusError{Status: http.StatusBadGateway, Body: "upstream"}, failover: true},
+		{name: "smtp rejected", err: &textproto.Error{Code: 550, Msg: "5.1.1 User unknown"}},
+		{name: "http rejected", err: &StatusError{Status: http.StatusBadRequest, Body: "bad recipient"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			primary := &stubTransport{name: "primary", err: test.err}
+			backup := &stubTransport{name: "backup"}
+			router := newRouter([]*route{
+				{name: "backup", priority: 1, weight: 1, transport: backup, breaker: newBreaker(1, time.Minute)},
+				{name: "primary", priority: 0, weight: 1, transport: primary, breaker: newBreaker(1, time.Minute)},
+			}, ratelimit.New(ratelimit.Config{}), quietLogger{})
+
+			receipt, err := router.Deliver(context.Background(), Message{To: []string{"jane@example.com"}})
+
+			if primary.sent != 1 {
+				t.Fatalf("primary sent = %d, want 1", primary.sent)
+			}
+
+			if !test.failover {
+				if err != test.err {
+					t.Errorf("err = %v, want %v", err, test.err)
+				}
+				if receipt.Provider != "primary" || backup.sent != 0 {
+					t.Errorf("provider = %q, backup sent = %d; want no failover", receipt.Provider, backup.sent)
+				}
+				if !router.routes[0].breaker.allow(time.Now()) {
+					t.Error("primary breaker opened on a permanent rejection")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("deliver: %v", err)
+			}
+			if receipt.Provider != "backup" || backup.sent != 1 {
+				t.Errorf("provider = %q, backup sent = %d; want failover to backup", receipt.Provider, backup.sent)
+			}
+			if router.routes[0].breaker.allow(time.Now()) {
+				t.Error("primary breaker did not record the failure")
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package delivery
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/mailer"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// SMTP delivers rendered messages through the relay configured under mailer.
+type SMTP struct {
+	auth smtp.Auth
+	host string
+	addr string
+	// timeout bounds the whole SMTP exchange, from dialing to QUIT.
+	timeout time.Duration
+	log     logger.Logger
+	tracer  tracing.Tracer
+}
+
+// Name implements Transport.
+func (s *SMTP) Name() string {
+	return TransportSMTP
+}
+
+// Send implements Transport.
+func (s *SMTP) Send(ctx context.Context, message Message) error {
+	ctx, span := s.tracer.Start(ctx, "infrastructure.delivery.smtp.send", trace.WithAttributes(
+		attribute.String("operation", "send"),
+		attribute.StringSlice("to", message.To),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	recipients, err := message.Recipients()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to parse recipients",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "send"),
+			zap.Error(err),
+		)
+
+		return err
+	}
+
+	body, err := message.Bytes()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to encode mail",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "send"),
+			zap.Error(err),
+		)
+
+		return err
+	}
+
+	if err := s.sendMail(ctx, message.Sender, recipients, body); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		s.log.Error("failed to send mail",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "send"),
+			zap.Error(err),
+		)
+
+		return err
+	}
+
+	s.log.Info("mail sent successfully",
+		zap.String("trace_id", traceId),
+		zap.String("operation", "send"),
+	)
+
+	return nil
+}
+
+// sendMail is smtp.SendMail bound to ctx: the connection is dialed with ctx,
+// carries a deadline, and is closed as soon as ctx is done so a stalled relay
+// cannot hold an email past its dispatcher lease.
+func (s *SMTP) sendMail(ctx context.Context, from string, to []string, body []byte) error {
+	deadline := time.Now().Add(s.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+
+	dialer := net.Dialer{Deadline: deadline}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return err
+		}
+	}
+
+	if ok, _ := client.Extension("AUTH"); ok && s.auth != nil {
+		if err := client.Auth(s.auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := writer.Write(body); err != nil {
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// NewSMTP builds the SMTP transport. The provider timeout, 30s by default,
+// must stay below the dispatcher lease.
+func NewSMTP(conf *mailer.Config, provider Provider, log logger.Logger, tracer tracing.Tracer) *SMTP {
+	timeout := provider.Timeout
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+
+	return &SMTP{
+		auth:    smtp.PlainAuth("", conf.Username, conf.Password, conf.Host),
+		host:    conf.Host,
+		addr:    fmt.Sprintf("%s:%d", conf.Host, conf.Port),
+		timeout: timeout,
+		log:     log,
+		tracer:  tracer,
+	}
+}
+
+var _ Transport = (*SMTP)(nil)
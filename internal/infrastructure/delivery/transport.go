@@ -0,0 +1,151 @@
+package delivery
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	"github.com/ose-micro/mailer"
+	"github.com/ose-micro/postman/internal/infrastructure/ratelimit"
+)
+
+// Transport hands a rendered message to a mail provider.
+type Transport interface {
+	// Name identifies the provider in attempt history.
+	Name() string
+	Send(ctx context.Context, message Message) error
+}
+
+// Submitter is implemented by transports whose provider assigns its own id to
+// each accepted message.
+type Submitter interface {
+	Submit(ctx context.Context, message Message) (string, error)
+}
+
+// Receipt tells which provider accepted a message and the id it gave it.
+type Receipt struct {
+	Provider   string
+	ProviderId string
+}
+
+const (
+	TransportSMTP = "smtp"
+	TransportHTTP = "http"
+	TransportFile = "file"
+	TransportNoop = "noop"
+)
+
+// Provider configures one delivery provider.
+type Provider struct {
+	// Name identifies the provider on email records; defaults to the transport.
+	Name string `mapstructure:"name"`
+	// Transport selects smtp (default, configured under mailer), http, file or noop.
+	Transport string `mapstructure:"transport"`
+	// Endpoint and ApiKey configure the http transport.
+	Endpoint string `mapstructure:"endpoint"`
+	ApiKey   string `mapstructure:"api_key"`
+	// Timeout bounds one smtp or http send and must stay below the dispatcher lease.
+	Timeout time.Duration `mapstructure:"timeout"`
+	// Directory is the maildir the file transport writes to.
+	Directory string `mapstructure:"directory"`
+	// Priority orders providers for failover, lowest first.
+	Priority int `mapstructure:"priority"`
+	// Weight shares traffic between providers of equal priority.
+	Weight int `mapstructure:"weight"`
+}
+
+type Config struct {
+	// Transport, Endpoint, ApiKey, Timeout and Directory describe a single
+	// provider and are used when Providers is empty.
+	Transport string        `mapstructure:"transport"`
+	Endpoint  string        `mapstructure:"endpoint"`
+	ApiKey    string        `mapstructure:"api_key"`
+	Timeout   time.Duration `mapstructure:"timeout"`
+	Directory string        `mapstructure:"directory"`
+	// Providers lists the providers to fail over between.
+	Providers []Provider `mapstructure:"providers"`
+	// BreakerThreshold consecutive transient failures open a provider's circuit
+	// for BreakerCooldown.
+	BreakerThreshold int           `mapstructure:"breaker_threshold"`
+	BreakerCooldown  time.Duration `mapstructure:"breaker_cooldown"`
+}
+
+func (c Config) providers() []Provider {
+	if len(c.Providers) > 0 {
+		return c.Providers
+	}
+
+	return []Provider{{
+		Transport: c.Transport,
+		Endpoint:  c.Endpoint,
+		ApiKey:    c.ApiKey,
+		Timeout:   c.Timeout,
+		Directory: c.Directory,
+	}}
+}
+
+func (c Config) withDefaults() Config {
+	if c.BreakerThreshold <= 0 {
+		c.BreakerThreshold = 5
+	}
+	if c.BreakerCooldown <= 0 {
+		c.BreakerCooldown = 30 * time.Second
+	}
+
+	return c
+}
+
+// New builds a Router over the providers configured in conf.
+func New(conf Config, smtpConf *mailer.Config, limiter *ratelimit.Limiter, log logger.Logger, tracer tracing.Tracer) (Transport, error) {
+	conf = conf.withDefaults()
+
+	routes := make([]*route, 0, len(conf.providers()))
+	names := make(map[string]bool)
+	for _, provider := range conf.providers() {
+		transport, err := newTransport(provider, smtpConf, log, tracer)
+		if err != nil {
+			return nil, err
+		}
+
+		name := provider.Name
+		if name == "" {
+			name = transport.Name()
+		}
+		if names[name] {
+			return nil, fmt.Errorf("duplicate delivery provider %q", name)
+		}
+		names[name] = true
+
+		weight := provider.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+
+		routes = append(routes, &route{
+			name:      name,
+			priority:  provider.Priority,
+			weight:    weight,
+			transport: transport,
+			breaker:   newBreaker(conf.BreakerThreshold, conf.BreakerCooldown),
+		})
+	}
+
+	return newRouter(routes, limiter, log), nil
+}
+
+func newTransport(provider Provider, smtpConf *mailer.Config, log logger.Logger, tracer tracing.Tracer) (Transport, error) {
+	switch provider.Transport {
+	case "", TransportSMTP:
+		return NewSMTP(smtpConf, provider, log, tracer), nil
+	case TransportHTTP:
+		return NewHTTP(provider, log, tracer)
+	case TransportFile:
+		return NewFile(provider, log, tracer)
+	case TransportNoop:
+		return NewNoop(log), nil
+	default:
+		return nil, fmt.Errorf("unknown delivery transport %q", provider.Transport)
+	}
+}
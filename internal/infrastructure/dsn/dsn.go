@@ -0,0 +1,140 @@
+// Package dsn reads RFC 3464 delivery status notifications, the bounce reports
+// MTAs send back when a message cannot be delivered.
+package dsn
+
+import (
+	"bufio"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+)
+
+// ErrNotReport is returned for messages that carry no delivery-status part.
+var ErrNotReport = errors.New("message is not a delivery status notification")
+
+// Report is one notification about a message we sent.
+type Report struct {
+	// MessageId is the Message-ID of the original message, angle brackets included.
+	MessageId    string
+	ReportingMTA string
+	Recipients   []Recipient
+}
+
+// Recipient is the per-recipient block of a report.
+type Recipient struct {
+	Address string
+	// Action is failed, delayed, delivered, relayed or expanded.
+	Action string
+	// Status is the RFC 3463 enhanced status code, e.g. 5.1.1.
+	Status     string
+	Diagnostic string
+}
+
+// Failed reports whether delivery to the recipient was abandoned.
+func (r Recipient) Failed() bool {
+	return strings.EqualFold(r.Action, "failed")
+}
+
+// Permanent reports a hard bounce: a failure with a 5.x.x status.
+func (r Recipient) Permanent() bool {
+	return r.Failed() && strings.HasPrefix(r.Status, "5")
+}
+
+// Parse reads a complete message and extracts its delivery status report.
+func Parse(r io.Reader) (*Report, error) {
+	message, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, err
+	}
+
+	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, ErrNotReport
+	}
+
+	report := &Report{}
+	found := false
+	parts := multipart.NewReader(message.Body, params["boundary"])
+	for {
+		part, err := parts.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		body := io.Reader(part)
+		if strings.EqualFold(part.Header.Get("Content-Transfer-Encoding"), "base64") {
+			body = base64.NewDecoder(base64.StdEncoding, part)
+		}
+
+		partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		switch partType {
+		case "message/delivery-status", "message/global-delivery-status":
+			if err := report.readStatus(body); err != nil {
+				return nil, err
+			}
+			found = true
+		case "message/rfc822", "text/rfc822-headers", "message/global", "message/global-headers":
+			header, _ := textproto.NewReader(bufio.NewReader(body)).ReadMIMEHeader()
+			report.MessageId = strings.TrimSpace(header.Get("Message-Id"))
+		}
+	}
+
+	if !found {
+		return nil, ErrNotReport
+	}
+
+	return report, nil
+}
+
+// readStatus parses the per-message fields followed by one block per recipient.
+func (r *Report) readStatus(body io.Reader) error {
+	reader := textproto.NewReader(bufio.NewReader(body))
+
+	fields, err := reader.ReadMIMEHeader()
+	if err != nil && err != io.EOF {
+		return err
+	}
+	r.ReportingMTA = value(fields.Get("Reporting-Mta"))
+
+	for err == nil {
+		var block textproto.MIMEHeader
+		block, err = reader.ReadMIMEHeader()
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		address := value(block.Get("Final-Recipient"))
+		if address == "" {
+			address = value(block.Get("Original-Recipient"))
+		}
+		if address == "" {
+			continue
+		}
+
+		r.Recipients = append(r.Recipients, Recipient{
+			Address:    address,
+			Action:     strings.ToLower(strings.TrimSpace(block.Get("Action"))),
+			Status:     strings.TrimSpace(block.Get("Status")),
+			Diagnostic: value(block.Get("Diagnostic-Code")),
+		})
+	}
+
+	return nil
+}
+
+// value drops the type prefix of typed fields such as "rfc822; user@example.com".
+func value(field string) string {
+	if _, rest, ok := strings.Cut(field, ";"); ok {
+		field = rest
+	}
+
+	return strings.TrimSpace(field)
+}
@@ -0,0 +1,102 @@
+package dsn
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseFixtures(t *testing.T) {
+	tests := []struct {
+		file       string
+		messageId  string
+		mta        string
+		address    string
+		action     string
+		status     string
+		diagnostic string
+		failed     bool
+		permanent  bool
+	}{
+		{
+			file:       "hard_bounce.eml",
+			messageId:  "<eml_0123456789.1@example.com>",
+			mta:        "mx.example.net",
+			address:    "nobody@example.net",
+			action:     "failed",
+			status:     "5.1.1",
+			diagnostic: "550 5.1.1 <nobody@example.net>: Recipient address rejected: User unknown in local recipient table",
+			failed:     true,
+			permanent:  true,
+		},
+		{
+			file:       "delayed.eml",
+			messageId:  "<eml_9876543210.1@example.com>",
+			mta:        "mx.example.net",
+			address:    "busy@example.org",
+			action:     "delayed",
+			status:     "4.4.1",
+			diagnostic: "connect to mx.example.org[192.0.2.10]:25: Connection timed out",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			file, err := os.Open(filepath.Join("testdata", test.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+
+			report, err := Parse(file)
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+
+			if report.MessageId != test.messageId {
+				t.Errorf("message id = %q, want %q", report.MessageId, test.messageId)
+			}
+			if report.ReportingMTA != test.mta {
+				t.Errorf("reporting mta = %q, want %q", report.ReportingMTA, test.mta)
+			}
+			if len(report.Recipients) != 1 {
+				t.Fatalf("recipients = %+v, want one", report.Recipients)
+			}
+
+			recipient := report.Recipients[0]
+			if recipient.Address != test.address {
+				t.Errorf("address = %q, want %q", recipient.Address, test.address)
+			}
+			if recipient.Action != test.action {
+				t.Errorf("action = %q, want %q", recipient.Action, test.action)
+			}
+			if recipient.Status != test.status {
+				t.Errorf("status = %q, want %q", recipient.Status, test.status)
+			}
+			if recipient.Diagnostic != test.diagnostic {
+				t.Errorf("diagnostic = %q, want %q", recipient.Diagnostic, test.diagnostic)
+			}
+			if recipient.Failed() != test.failed {
+				t.Errorf("failed = %v, want %v", recipient.Failed(), test.failed)
+			}
+			if recipient.Permanent() != test.permanent {
+				t.Errorf("permanent = %v, want %v", recipient.Permanent(), test.permanent)
+			}
+		})
+	}
+}
+
+func TestParseNotReport(t *testing.T) {
+	message := "From: someone@example.org\r\n" +
+		"To: noreply@example.com\r\n" +
+		"Subject: Out of office\r\n" +
+		"Content-Type: text/plain\r\n" +
+		"\r\n" +
+		"I am away until Monday.\r\n"
+
+	if _, err := Parse(strings.NewReader(message)); !errors.Is(err, ErrNotReport) {
+		t.Fatalf("err = %v, want ErrNotReport", err)
+	}
+}
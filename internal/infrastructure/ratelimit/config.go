@@ -0,0 +1,45 @@
+package ratelimit
+
+import "time"
+
+// Limit is a token bucket refilled at Rate tokens per second holding at most
+// Burst tokens. A zero Rate leaves the scope unlimited.
+type Limit struct {
+	Rate  float64 `mapstructure:"rate"`
+	Burst int     `mapstructure:"burst"`
+}
+
+type Config struct {
+	// Providers limits each delivery provider by name.
+	Providers map[string]Limit `mapstructure:"providers"`
+	// Domain applies to every recipient domain not listed in Domains.
+	Domain  Limit            `mapstructure:"domain"`
+	Domains map[string]Limit `mapstructure:"domains"`
+	// Sender applies to every sender address not listed in Senders.
+	Sender  Limit            `mapstructure:"sender"`
+	Senders map[string]Limit `mapstructure:"senders"`
+	// Idle is how long a full bucket is kept after its last use. A full bucket
+	// is the same as a new one, so dropping it only resets its throttled count.
+	Idle time.Duration `mapstructure:"idle"`
+}
+
+const defaultIdle = 10 * time.Minute
+
+func (c Config) limit(key Key) Limit {
+	switch key.Scope {
+	case ScopeProvider:
+		return c.Providers[key.Name]
+	case ScopeDomain:
+		if limit, ok := c.Domains[key.Name]; ok {
+			return limit
+		}
+		return c.Domain
+	case ScopeSender:
+		if limit, ok := c.Senders[key.Name]; ok {
+			return limit
+		}
+		return c.Sender
+	default:
+		return Limit{}
+	}
+}
@@ -0,0 +1,236 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Scope string
+
+const (
+	ScopeProvider Scope = "provider"
+	ScopeDomain   Scope = "domain"
+	ScopeSender   Scope = "sender"
+)
+
+// Key names one bucket, such as the domain gmail.com.
+type Key struct {
+	Scope Scope
+	Name  string
+}
+
+// ThrottledError reports that a limit was hit and when a token frees up.
+type ThrottledError struct {
+	Key  Key
+	Wait time.Duration
+}
+
+func (t *ThrottledError) Error() string {
+	return fmt.Sprintf("%s %s rate limited for %s", t.Key.Scope, t.Key.Name, t.Wait)
+}
+
+// State is a snapshot of one bucket.
+type State struct {
+	Key       Key
+	Rate      float64
+	Burst     int
+	Tokens    float64
+	Throttled int64
+	UpdatedAt time.Time
+}
+
+type bucket struct {
+	limit     Limit
+	tokens    float64
+	throttled int64
+	updatedAt time.Time
+}
+
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.updatedAt).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(float64(b.limit.Burst), b.tokens+elapsed*b.limit.Rate)
+		b.updatedAt = now
+	}
+}
+
+// full reports whether the bucket would hold Burst tokens at now.
+func (b *bucket) full(now time.Time) bool {
+	return b.tokens+now.Sub(b.updatedAt).Seconds()*b.limit.Rate >= float64(b.limit.Burst)
+}
+
+// wait is how long until the bucket holds a whole token.
+func (b *bucket) wait() time.Duration {
+	if b.tokens >= 1 {
+		return 0
+	}
+
+	return time.Duration((1 - b.tokens) / b.limit.Rate * float64(time.Second))
+}
+
+// Limiter keeps a token bucket per limited key. Buckets live in process
+// memory, so each postman instance enforces the limits on its own.
+type Limiter struct {
+	mu      sync.Mutex
+	conf    Config
+	buckets map[Key]*bucket
+	// sweptAt is when idle buckets were last evicted.
+	sweptAt time.Time
+	// now is the clock, replaced in tests.
+	now func() time.Time
+}
+
+// Take removes one token from every bucket in keys, or from none of them when
+// any is empty, in which case the longest wait is returned as a ThrottledError.
+func (l *Limiter) Take(keys ...Key) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	var throttled *ThrottledError
+	buckets := make([]*bucket, 0, len(keys))
+	for _, key := range keys {
+		b := l.bucket(key, now)
+		if b == nil {
+			continue
+		}
+
+		b.refill(now)
+		if wait := b.wait(); wait > 0 {
+			b.throttled++
+			if throttled == nil || wait > throttled.Wait {
+				throttled = &ThrottledError{Key: key, Wait: wait}
+			}
+		}
+
+		buckets = append(buckets, b)
+	}
+
+	if throttled != nil {
+		return throttled
+	}
+
+	for _, b := range buckets {
+		b.tokens--
+	}
+
+	return nil
+}
+
+// Refund returns a token taken from each bucket in keys, for a send that was
+// held back before it reached a provider.
+func (l *Limiter) Refund(keys ...Key) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, key := range keys {
+		if b, ok := l.buckets[key]; ok {
+			b.tokens = math.Min(float64(b.limit.Burst), b.tokens+1)
+		}
+	}
+}
+
+// sweep evicts buckets that are full and unused for the idle period, so a
+// stream of one-off recipient domains does not grow the map forever. It runs
+// at most once per idle period.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.sweptAt) < l.conf.Idle {
+		return
+	}
+	l.sweptAt = now
+
+	for key, b := range l.buckets {
+		if now.Sub(b.updatedAt) >= l.conf.Idle && b.full(now) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// Snapshot lists every bucket in use, ordered by scope and name.
+func (l *Limiter) Snapshot() []State {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	states := make([]State, 0, len(l.buckets))
+	for key, b := range l.buckets {
+		b.refill(now)
+		states = append(states, State{
+			Key:       key,
+			Rate:      b.limit.Rate,
+			Burst:     b.limit.Burst,
+			Tokens:    b.tokens,
+			Throttled: b.throttled,
+			UpdatedAt: b.updatedAt,
+		})
+	}
+
+	sort.Slice(states, func(i, j int) bool {
+		if states[i].Key.Scope != states[j].Key.Scope {
+			return states[i].Key.Scope < states[j].Key.Scope
+		}
+		return states[i].Key.Name < states[j].Key.Name
+	})
+
+	return states
+}
+
+// bucket returns the bucket for key, creating it full, or nil when key is unlimited.
+func (l *Limiter) bucket(key Key, now time.Time) *bucket {
+	if b, ok := l.buckets[key]; ok {
+		return b
+	}
+
+	limit := l.conf.limit(key)
+	if limit.Rate <= 0 {
+		return nil
+	}
+
+	if limit.Burst <= 0 {
+		limit.Burst = int(math.Max(1, math.Ceil(limit.Rate)))
+	}
+
+	b := &bucket{limit: limit, tokens: float64(limit.Burst), updatedAt: now}
+	l.buckets[key] = b
+
+	return b
+}
+
+// Domain returns the domain key of a recipient address.
+func Domain(address string) Key {
+	name := address
+	if at := strings.LastIndex(address, "@"); at >= 0 {
+		name = address[at+1:]
+	}
+
+	return Key{Scope: ScopeDomain, Name: strings.ToLower(strings.TrimSuffix(name, ">"))}
+}
+
+// Sender returns the key of a sender address.
+func Sender(address string) Key {
+	return Key{Scope: ScopeSender, Name: strings.ToLower(address)}
+}
+
+// Provider returns the key of a delivery provider.
+func Provider(name string) Key {
+	return Key{Scope: ScopeProvider, Name: name}
+}
+
+func New(conf Config) *Limiter {
+	if conf.Idle <= 0 {
+		conf.Idle = defaultIdle
+	}
+
+	return &Limiter{
+		conf:    conf,
+		buckets: make(map[Key]*bucket),
+		sweptAt: time.Now(),
+		now:     time.Now,
+	}
+}
@@ -0,0 +1,158 @@
+package ratelimit
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLimiter(t *testing.T) {
+	domain := Domain("jane@example.com")
+	sender := Sender("noreply@example.com")
+	provider := Provider("smtp")
+
+	type step struct {
+		// at is the clock, relative to the start of the test
+		at     time.Duration
+		take   []Key
+		refund []Key
+		// throttled is the key a take must be refused on; zero when it must succeed
+		throttled Key
+	}
+
+	tests := []struct {
+		name  string
+		conf  Config
+		steps []step
+		// tokens left per bucket at the end; a bucket missing here must not exist
+		tokens map[Key]float64
+	}{
+		{
+			name: "take within burst",
+			conf: Config{Domain: Limit{Rate: 1, Burst: 2}},
+			steps: []step{
+				{take: []Key{domain}},
+				{take: []Key{domain}},
+				{take: []Key{domain}, throttled: domain},
+			},
+			tokens: map[Key]float64{domain: 0},
+		},
+		{
+			name: "refill over time",
+			conf: Config{Domain: Limit{Rate: 1, Burst: 2}},
+			steps: []step{
+				{take: []Key{domain}},
+				{take: []Key{domain}},
+				{at: 500 * time.Millisecond, take: []Key{domain}, throttled: domain},
+				{at: time.Second, take: []Key{domain}},
+			},
+			tokens: map[Key]float64{domain: 0},
+		},
+		{
+			name: "all or nothing across keys",
+			conf: Config{Domain: Limit{Rate: 1, Burst: 2}, Sender: Limit{Rate: 1, Burst: 1}},
+			steps: []step{
+				{take: []Key{sender}},
+				{take: []Key{domain, sender}, throttled: sender},
+			},
+			tokens: map[Key]float64{domain: 2, sender: 0},
+		},
+		{
+			name: "unlimited key takes no bucket",
+			conf: Config{Domain: Limit{Rate: 1, Burst: 1}},
+			steps: []step{
+				{take: []Key{provider, domain}},
+				{take: []Key{provider}},
+			},
+			tokens: map[Key]float64{domain: 0},
+		},
+		{
+			name: "refund capped at burst",
+			conf: Config{Domain: Limit{Rate: 1, Burst: 2}},
+			steps: []step{
+				{take: []Key{domain}},
+				{refund: []Key{domain}},
+				{refund: []Key{domain}},
+			},
+			tokens: map[Key]float64{domain: 2},
+		},
+		{
+			name: "refund without bucket",
+			conf: Config{Domain: Limit{Rate: 1, Burst: 2}},
+			steps: []step{
+				{refund: []Key{domain}},
+			},
+			tokens: map[Key]float64{},
+		},
+		{
+			name: "sweep evicts idle full buckets",
+			conf: Config{Domain: Limit{Rate: 1, Burst: 1}, Sender: Limit{Rate: 1, Burst: 1}, Idle: time.Minute},
+			steps: []step{
+				{take: []Key{domain}},
+				{at: 2 * time.Minute, take: []Key{sender}},
+			},
+			tokens: map[Key]float64{sender: 0},
+		},
+		{
+			name: "sweep keeps buckets still refilling",
+			conf: Config{Domain: Limit{Rate: 0.001, Burst: 1}, Sender: Limit{Rate: 1, Burst: 1}, Idle: time.Minute},
+			steps: []step{
+				{take: []Key{domain}},
+				{at: 2 * time.Minute, take: []Key{sender}},
+			},
+			tokens: map[Key]float64{domain: 0, sender: 0},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			start := time.Now()
+			now := start
+
+			limiter := New(test.conf)
+			limiter.now = func() time.Time { return now }
+
+			for i, step := range test.steps {
+				now = start.Add(step.at)
+
+				if step.refund != nil {
+					limiter.Refund(step.refund...)
+				}
+				if step.take == nil {
+					continue
+				}
+
+				err := limiter.Take(step.take...)
+				if step.throttled == (Key{}) {
+					if err != nil {
+						t.Fatalf("step %d: take: %v", i, err)
+					}
+					continue
+				}
+
+				var throttled *ThrottledError
+				if !errors.As(err, &throttled) || throttled.Key != step.throttled {
+					t.Fatalf("step %d: err = %v, want %s throttled", i, err, step.throttled.Name)
+				}
+				if throttled.Wait <= 0 {
+					t.Errorf("step %d: wait = %v, want positive", i, throttled.Wait)
+				}
+			}
+
+			if len(limiter.buckets) != len(test.tokens) {
+				t.Errorf("buckets = %d, want %d", len(limiter.buckets), len(test.tokens))
+			}
+			for key, want := range test.tokens {
+				b, ok := limiter.buckets[key]
+				if !ok {
+					t.Errorf("bucket %s missing", key.Name)
+					continue
+				}
+				if math.Abs(b.tokens-want) > 0.01 {
+					t.Errorf("bucket %s tokens = %v, want %v", key.Name, b.tokens, want)
+				}
+			}
+		})
+	}
+}
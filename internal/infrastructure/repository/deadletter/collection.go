@@ -0,0 +1,47 @@
+package deadletter
+
+import (
+	"time"
+
+	"github.com/ose-micro/postman/internal/business/deadletter"
+)
+
+type Letter struct {
+	Id         string           `bson:"_id"`
+	Subject    string           `bson:"subject"`
+	Payload    string           `bson:"payload"`
+	Reason     string           `bson:"reason"`
+	Kind       deadletter.Kind  `bson:"kind"`
+	Attempts   int32            `bson:"attempts"`
+	State      deadletter.State `bson:"state"`
+	CreatedAt  time.Time        `bson:"created_at"`
+	ReplayedAt *time.Time       `bson:"replayed_at"`
+}
+
+func (l Letter) public() deadletter.Letter {
+	return deadletter.Letter{
+		Id:         l.Id,
+		Subject:    l.Subject,
+		Payload:    l.Payload,
+		Reason:     l.Reason,
+		Kind:       l.Kind,
+		Attempts:   l.Attempts,
+		State:      l.State,
+		CreatedAt:  l.CreatedAt,
+		ReplayedAt: l.ReplayedAt,
+	}
+}
+
+func newLetter(letter deadletter.Letter) Letter {
+	return Letter{
+		Id:         letter.Id,
+		Subject:    letter.Subject,
+		Payload:    letter.Payload,
+		Reason:     letter.Reason,
+		Kind:       letter.Kind,
+		Attempts:   letter.Attempts,
+		State:      letter.State,
+		CreatedAt:  letter.CreatedAt,
+		ReplayedAt: letter.ReplayedAt,
+	}
+}
@@ -0,0 +1,233 @@
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	ose_error "github.com/ose-micro/error"
+	mongodb "github.com/ose-micro/mongo"
+	"github.com/ose-micro/postman/internal/business/deadletter"
+	"github.com/ose-micro/postman/internal/business/event"
+	"github.com/ose-micro/postman/internal/infrastructure/repository/outbox"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type repository struct {
+	collection *mongo.Collection
+	outbox     *mongo.Collection
+	log        logger.Logger
+	tracer     tracing.Tracer
+}
+
+// Create implements deadletter.Repo.
+func (r *repository) Create(ctx context.Context, letter deadletter.Letter) error {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.deadletter.create", trace.WithAttributes(
+		attribute.String("operation", "create"),
+		attribute.String("id", letter.Id),
+		attribute.String("subject", letter.Subject),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	message := event.New(deadletter.Topic(letter.Subject), letter.CreatedAt, letter.Message())
+	if err := outbox.Transaction(ctx, r.collection, func(ctx context.Context) error {
+		if _, err := r.collection.InsertOne(ctx, newLetter(letter)); err != nil {
+			return err
+		}
+
+		return outbox.Write(ctx, r.outbox, message)
+	}); err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to create in mongo",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "create"),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	r.log.Info("create process complete successfully",
+		zap.String("operation", "create"),
+		zap.String("trace_id", traceId),
+		zap.String("id", letter.Id),
+		zap.String("kind", string(letter.Kind)),
+	)
+	return nil
+}
+
+// Read implements deadletter.Repo.
+func (r *repository) Read(ctx context.Context, request dto.Request) (map[string]any, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.deadletter.read", trace.WithAttributes(
+		attribute.String("operation", "read"),
+		attribute.String("payload", fmt.Sprintf("%+v", request)),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+	mongodb.RegisterType("deadletter", deadletter.Letter{})
+	typeHints := map[string]string{}
+
+	for _, v := range request.Queries {
+		typeHints[v.Name] = "deadletter"
+	}
+
+	res, err := mongodb.RunFaceted(ctx, r.collection, request)
+	if err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.log.Error("Failed to fetch by request",
+			zap.String("operation", "read"),
+			zap.String("trace_id", traceID),
+			zap.Any("payload", request),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	records, err := mongodb.CastFacetedResult(res, typeHints)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("Failed to cast faceted result",
+			zap.String("operation", "read"),
+			zap.String("trace_id", traceID),
+			zap.Any("payload", request),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// ReadOne implements deadletter.Repo.
+func (r *repository) ReadOne(ctx context.Context, id string) (*deadletter.Letter, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.deadletter.read_one", trace.WithAttributes(
+		attribute.String("operation", "read_one"),
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	var record Letter
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&record); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			err = ose_error.New(ose_error.ErrNotFound, "dead letter not found", traceId)
+		} else {
+			err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to read dead letter",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read_one"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	letter := record.public()
+	return &letter, nil
+}
+
+// Update implements deadletter.Repo.
+func (r *repository) Update(ctx context.Context, letter deadletter.Letter) error {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.deadletter.update", trace.WithAttributes(
+		attribute.String("operation", "update"),
+		attribute.String("id", letter.Id),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": letter.Id}, bson.M{
+		"$set": newLetter(letter),
+	}); err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to update dead letter",
+			zap.String("operation", "update"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	r.log.Info("update process complete successfully",
+		zap.String("operation", "update"),
+		zap.String("trace_id", traceID),
+		zap.String("id", letter.Id),
+		zap.String("state", string(letter.State)),
+	)
+
+	return nil
+}
+
+// Purge implements deadletter.Repo.
+func (r *repository) Purge(ctx context.Context, id string) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.deadletter.purge", trace.WithAttributes(
+		attribute.String("operation", "purge"),
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	filter := bson.M{}
+	if id != "" {
+		filter = bson.M{"_id": id}
+	}
+
+	res, err := r.collection.DeleteMany(ctx, filter)
+	if err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to purge dead letters",
+			zap.String("operation", "purge"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return 0, err
+	}
+
+	r.log.Info("purge process complete successfully",
+		zap.String("operation", "purge"),
+		zap.String("trace_id", traceID),
+		zap.Int64("purged", res.DeletedCount),
+	)
+
+	return res.DeletedCount, nil
+}
+
+func NewRepository(db *mongodb.Client, log logger.Logger, tracer tracing.Tracer) deadletter.Repo {
+	collection := db.Collection("dead_letters")
+	if _, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "created_at", Value: -1}},
+	}); err != nil {
+		log.Error("failed to ensure dead letter index", zap.Error(err))
+	}
+
+	return &repository{
+		log:        log,
+		tracer:     tracer,
+		collection: collection,
+		outbox:     db.Collection(outbox.Name),
+	}
+}
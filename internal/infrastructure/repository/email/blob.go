@@ -0,0 +1,110 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ose_error "github.com/ose-micro/error"
+	"github.com/ose-micro/rid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Blob holds attachment bytes outside the email document so listing emails stays cheap.
+type Blob struct {
+	Id        string    `bson:"_id"`
+	Content   []byte    `bson:"content"`
+	Size      int64     `bson:"size"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// CreateBlob implements email.Repo.
+func (r *repository) CreateBlob(ctx context.Context, content []byte) (string, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.email.create_blob", trace.WithAttributes(
+		attribute.String("operation", "create_blob"),
+		attribute.Int("size", len(content)),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	record := Blob{
+		Id:        rid.New("blb", true).String(),
+		Content:   content,
+		Size:      int64(len(content)),
+		CreatedAt: time.Now(),
+	}
+	if _, err := r.blobs.InsertOne(ctx, record); err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to create blob in mongo",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "create_blob"),
+			zap.Error(err),
+		)
+		return "", err
+	}
+
+	return record.Id, nil
+}
+
+// ReadBlob implements email.Repo.
+func (r *repository) ReadBlob(ctx context.Context, id string) ([]byte, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.email.read_blob", trace.WithAttributes(
+		attribute.String("operation", "read_blob"),
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	var record Blob
+	if err := r.blobs.FindOne(ctx, bson.M{"_id": id}).Decode(&record); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			err = ose_error.New(ose_error.ErrNotFound, "attachment blob not found", traceId)
+		} else {
+			err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to read blob",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read_blob"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return record.Content, nil
+}
+
+// DeleteBlobs implements email.Repo.
+func (r *repository) DeleteBlobs(ctx context.Context, ids []string) error {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.email.delete_blobs", trace.WithAttributes(
+		attribute.String("operation", "delete_blobs"),
+		attribute.StringSlice("ids", ids),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	if _, err := r.blobs.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}}); err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to delete blobs",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "delete_blobs"),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
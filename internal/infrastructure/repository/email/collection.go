@@ -7,35 +7,173 @@ import (
 )
 
 type Email struct {
-	Id        string                 `bson:"_id"`
-	Recipient string                 `bson:"recipient,omitempty"`
-	Sender    string                 `bson:"sender,omitempty"`
-	Subject   string                 `bson:"subject,omitempty"`
-	Data      map[string]interface{} `bson:"data,omitempty"`
-	Template  string                 `bson:"template,omitempty"`
-	From      string                 `bson:"from,omitempty"`
-	Message   string                 `bson:"message,omitempty"`
-	State     email.State            `bson:"state,omitempty"`
-	Version   int32                  `bson:"version,omitempty"`
-	CreatedAt time.Time              `bson:"created_at"`
-	UpdatedAt time.Time              `bson:"updated_at"`
-	DeletedAt *time.Time             `bson:"deleted_at"`
+	Id              string                 `bson:"_id"`
+	Recipient       string                 `bson:"recipient,omitempty"`
+	To              []string               `bson:"to,omitempty"`
+	Cc              []string               `bson:"cc,omitempty"`
+	Bcc             []string               `bson:"bcc,omitempty"`
+	ReplyTo         string                 `bson:"reply_to,omitempty"`
+	Sender          string                 `bson:"sender,omitempty"`
+	Subject         string                 `bson:"subject,omitempty"`
+	Data            map[string]interface{} `bson:"data,omitempty"`
+	Template        string                 `bson:"template,omitempty"`
+	TemplateVersion int32                  `bson:"template_version,omitempty"`
+	From            string                 `bson:"from,omitempty"`
+	Message         string                 `bson:"message,omitempty"`
+	Text            string                 `bson:"text,omitempty"`
+	State           email.State            `bson:"state,omitempty"`
+	Tries           int32                  `bson:"tries"`
+	Attempts        []Attempt              `bson:"attempts"`
+	Count           int32                  `bson:"count"`
+	LastError       string                 `bson:"last_error"`
+	RetryAt         *time.Time             `bson:"retry_at"`
+	SendAt          *time.Time             `bson:"send_at,omitempty"`
+	IdempotencyKey  string                 `bson:"idempotency_key,omitempty"`
+	Attachments     []Attachment           `bson:"attachments,omitempty"`
+	Headers         map[string]string      `bson:"headers,omitempty"`
+	Tags            map[string]string      `bson:"tags,omitempty"`
+	MessageId       string                 `bson:"message_id,omitempty"`
+	Provider        string                 `bson:"provider,omitempty"`
+	Category        string                 `bson:"category,omitempty"`
+	Suppressed      []string               `bson:"suppressed,omitempty"`
+	Version         int32                  `bson:"version,omitempty"`
+	CreatedAt       time.Time              `bson:"created_at"`
+	UpdatedAt       time.Time              `bson:"updated_at"`
+	DeletedAt       *time.Time             `bson:"deleted_at"`
+	// ClaimedUntil is the dispatcher lease; it is never set through the domain.
+	ClaimedUntil *time.Time `bson:"claimed_until,omitempty"`
+	// ClaimToken identifies the claim holding the lease.
+	ClaimToken string `bson:"claim_token,omitempty"`
+}
+
+func (e Email) public() email.Public {
+	return email.Public{
+		Id:              e.Id,
+		Recipient:       e.Recipient,
+		To:              e.To,
+		Cc:              e.Cc,
+		Bcc:             e.Bcc,
+		ReplyTo:         e.ReplyTo,
+		Sender:          e.Sender,
+		Subject:         e.Subject,
+		Data:            e.Data,
+		Template:        e.Template,
+		TemplateVersion: e.TemplateVersion,
+		From:            e.From,
+		Message:         e.Message,
+		Text:            e.Text,
+		State:           e.State,
+		Tries:           e.Tries,
+		Attempts:        e.attempts(),
+		Count:           e.Count,
+		LastError:       e.LastError,
+		RetryAt:         e.RetryAt,
+		SendAt:          e.SendAt,
+		IdempotencyKey:  e.IdempotencyKey,
+		Attachments:     e.attachments(),
+		Headers:         e.Headers,
+		Tags:            e.Tags,
+		MessageId:       e.MessageId,
+		Provider:        e.Provider,
+		Category:        e.Category,
+		Suppressed:      e.Suppressed,
+		Version:         e.Version,
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
+		DeletedAt:       e.DeletedAt,
+	}
 }
 
 func newCollection(params email.Domain) Email {
 	return Email{
-		Id:        params.ID(),
-		Recipient: params.Recipient(),
-		Sender:    params.Sender(),
-		Subject:   params.Subject(),
-		Data:      params.Data(),
-		Template:  params.Template(),
-		From:      params.From(),
-		Message:   params.Message(),
-		State:     params.State(),
-		Version:   params.Version(),
-		CreatedAt: params.CreatedAt(),
-		UpdatedAt: params.UpdatedAt(),
-		DeletedAt: params.DeletedAt(),
+		Id:              params.ID(),
+		Recipient:       params.Recipient(),
+		To:              params.To(),
+		Cc:              params.Cc(),
+		Bcc:             params.Bcc(),
+		ReplyTo:         params.ReplyTo(),
+		Sender:          params.Sender(),
+		Subject:         params.Subject(),
+		Data:            params.Data(),
+		Template:        params.Template(),
+		TemplateVersion: params.TemplateVersion(),
+		From:            params.From(),
+		Message:         params.Message(),
+		Text:            params.Text(),
+		State:           params.State(),
+		Tries:           params.Tries(),
+		Attempts:        newAttempts(params.Attempts()),
+		Count:           int32(len(params.Attempts())),
+		LastError:       params.LastError(),
+		RetryAt:         params.RetryAt(),
+		SendAt:          params.SendAt(),
+		IdempotencyKey:  params.IdempotencyKey(),
+		Attachments:     newAttachments(params.Attachments()),
+		Headers:         params.Headers(),
+		Tags:            params.Tags(),
+		MessageId:       params.MessageId(),
+		Provider:        params.Provider(),
+		Category:        params.Category(),
+		Suppressed:      params.Suppressed(),
+		Version:         params.Version(),
+		CreatedAt:       params.CreatedAt(),
+		UpdatedAt:       params.UpdatedAt(),
+		DeletedAt:       params.DeletedAt(),
+	}
+}
+
+type Attempt struct {
+	At         time.Time `bson:"at"`
+	Provider   string    `bson:"provider,omitempty"`
+	Code       int32     `bson:"code,omitempty"`
+	Response   string    `bson:"response,omitempty"`
+	Error      string    `bson:"error,omitempty"`
+	DurationMs int64     `bson:"duration_ms"`
+	MessageId  string    `bson:"message_id,omitempty"`
+	ProviderId string    `bson:"provider_id,omitempty"`
+}
+
+func newAttempts(attempts []email.Attempt) []Attempt {
+	records := make([]Attempt, 0, len(attempts))
+	for _, a := range attempts {
+		records = append(records, Attempt(a))
+	}
+
+	return records
+}
+
+func (e Email) attempts() []email.Attempt {
+	attempts := make([]email.Attempt, 0, len(e.Attempts))
+	for _, a := range e.Attempts {
+		attempts = append(attempts, email.Attempt(a))
 	}
+
+	return attempts
+}
+
+type Attachment struct {
+	Filename    string `bson:"filename"`
+	ContentType string `bson:"content_type,omitempty"`
+	Size        int64  `bson:"size"`
+	Blob        string `bson:"blob"`
+	ContentId   string `bson:"content_id,omitempty"`
+	Inline      bool   `bson:"inline,omitempty"`
+}
+
+func newAttachments(attachments []email.Attachment) []Attachment {
+	records := make([]Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		records = append(records, Attachment(a))
+	}
+
+	return records
+}
+
+func (e Email) attachments() []email.Attachment {
+	attachments := make([]email.Attachment, 0, len(e.Attachments))
+	for _, a := range e.Attachments {
+		attachments = append(attachments, email.Attachment(a))
+	}
+
+	return attachments
 }
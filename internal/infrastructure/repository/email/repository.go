@@ -2,7 +2,9 @@ package email
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ose-micro/common"
 	"github.com/ose-micro/core/dto"
@@ -12,19 +14,41 @@ import (
 	mongodb "github.com/ose-micro/mongo"
 	"github.com/ose-micro/postman/internal/business"
 	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/postman/internal/business/event"
+	"github.com/ose-micro/postman/internal/infrastructure/repository/outbox"
+	"github.com/ose-micro/postman/internal/infrastructure/repository/subscription"
+	"github.com/ose-micro/rid"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
+// errStateChanged aborts a conditional save whose email left the expected state.
+var errStateChanged = errors.New("email state changed")
+
 type repository struct {
-	collection *mongo.Collection
-	log        logger.Logger
-	tracer     tracing.Tracer
-	bs         business.Domain
+	collection    *mongo.Collection
+	blobs         *mongo.Collection
+	outbox        *mongo.Collection
+	notifications *mongo.Collection
+	log           logger.Logger
+	tracer        tracing.Tracer
+	bs            business.Domain
+}
+
+// announce writes the events and the webhook notification owed for the
+// changes of payload. Call it inside the transaction that saves them.
+func (r *repository) announce(ctx context.Context, payload email.Domain) error {
+	record, changes := payload.Public(), payload.Changes()
+	if err := outbox.Write(ctx, r.outbox, event.EmailEvents(record, changes)...); err != nil {
+		return err
+	}
+
+	return subscription.Queue(ctx, r.notifications, record, changes)
 }
 
 func (r *repository) ReadOne(ctx context.Context, request dto.Request) (*email.Domain, error) {
@@ -95,8 +119,19 @@ func (r *repository) ReadOne(ctx context.Context, request dto.Request) (*email.D
 
 // Create implements email.Repository.
 func (r *repository) Create(ctx context.Context, payload email.Domain) error {
-	ctx, span := r.tracer.Start(ctx, "repository.infrastructure.email.create", trace.WithAttributes(
-		attribute.String("operation", "create"),
+	return r.create(ctx, "create", payload, "")
+}
+
+// Supersede implements email.Repo.
+func (r *repository) Supersede(ctx context.Context, payload email.Domain, previous string) error {
+	return r.create(ctx, "supersede", payload, previous)
+}
+
+// create inserts payload, first taking its idempotency key from the email with
+// id previous when there is one.
+func (r *repository) create(ctx context.Context, operation string, payload email.Domain, previous string) error {
+	ctx, span := r.tracer.Start(ctx, "repository.infrastructure.email."+operation, trace.WithAttributes(
+		attribute.String("operation", operation),
 		attribute.String("payload", fmt.Sprintf("%v", payload.Public())),
 	))
 	defer span.End()
@@ -104,20 +139,41 @@ func (r *repository) Create(ctx context.Context, payload email.Domain) error {
 	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
 
 	record := newCollection(payload)
-	if _, err := r.collection.InsertOne(ctx, record); err != nil {
-		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+	err := outbox.Transaction(ctx, r.collection, func(ctx context.Context) error {
+		if previous != "" {
+			// a concurrent create may have taken the key already; the insert then conflicts
+			if _, err := r.collection.UpdateOne(ctx, bson.M{
+				"_id":             previous,
+				"idempotency_key": payload.IdempotencyKey(),
+			}, bson.M{"$unset": bson.M{"idempotency_key": ""}}); err != nil {
+				return err
+			}
+		}
+
+		if _, err := r.collection.InsertOne(ctx, record); err != nil {
+			return err
+		}
+
+		return r.announce(ctx, payload)
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			err = ose_error.Wrap(err, ose_error.ErrConflict, "email already exist with this idempotency key", traceId)
+		} else {
+			err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		}
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		r.log.Error("failed to create in mongo",
 			zap.String("trace_id", traceId),
-			zap.String("operation", "create"),
+			zap.String("operation", operation),
 			zap.Error(err),
 		)
 		return err
 	}
 
-	r.log.Info("create process complete successfully",
-		zap.String("operation", "create"),
+	r.log.Info(operation+" process complete successfully",
+		zap.String("operation", operation),
 		zap.String("trace_id", traceId),
 		zap.Any("payload", payload.Public()),
 	)
@@ -223,8 +279,16 @@ func (r *repository) Update(ctx context.Context, payload email.Domain) error {
 	collection := newCollection(payload)
 	filter := bson.M{"_id": payload.ID()}
 
-	if _, err := r.collection.UpdateOne(ctx, filter, bson.M{
-		"$set": collection,
+	// saving an email always releases the dispatcher lease
+	if err := outbox.Transaction(ctx, r.collection, func(ctx context.Context) error {
+		if _, err := r.collection.UpdateOne(ctx, filter, bson.M{
+			"$set":   collection,
+			"$unset": bson.M{"claimed_until": "", "claim_token": ""},
+		}); err != nil {
+			return err
+		}
+
+		return r.announce(ctx, payload)
 	}); err != nil {
 		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
 		span.RecordError(err)
@@ -247,16 +311,275 @@ func (r *repository) Update(ctx context.Context, payload email.Domain) error {
 	return nil
 }
 
+// Claim implements email.Repo.
+func (r *repository) Claim(ctx context.Context, lease time.Duration) (*email.Domain, *email.Lease, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.email.claim", trace.WithAttributes(
+		attribute.String("operation", "claim"),
+		attribute.String("lease", lease.String()),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	now := time.Now()
+	// a Sending email whose lease ran out belongs to a worker that died mid-delivery
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{
+				"state": email.StateQueued,
+				"$or": bson.A{
+					bson.M{"retry_at": nil},
+					bson.M{"retry_at": bson.M{"$lte": now}},
+				},
+			},
+			bson.M{
+				"state":         email.StateSending,
+				"claimed_until": bson.M{"$lte": now},
+			},
+		},
+	}
+	claim := &email.Lease{
+		Token: rid.New("lse", true).String(),
+		Until: now.Add(lease),
+	}
+	update := bson.M{"$set": bson.M{
+		"state":         email.StateSending,
+		"claimed_until": claim.Until,
+		"claim_token":   claim.Token,
+	}}
+	// the document as it was before the claim tells a fresh claim from a reclaim
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetReturnDocument(options.Before)
+
+	var record Email
+	if err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&record); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil, nil
+		}
+
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to claim email",
+			zap.String("operation", "claim"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return nil, nil, err
+	}
+
+	claimed := r.toDomain(record.public())
+	if claimed.State() == email.StateQueued {
+		if err := claimed.Transition(email.StateSending); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return claimed, claim, nil
+}
+
+// Release implements email.Repo.
+func (r *repository) Release(ctx context.Context, at time.Time) ([]*email.Domain, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.email.release", trace.WithAttributes(
+		attribute.String("operation", "release"),
+		attribute.String("at", at.String()),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// emails are released one at a time so each transition can be reported
+	released := make([]*email.Domain, 0)
+	for {
+		var scheduled *email.Domain
+		err := outbox.Transaction(ctx, r.collection, func(ctx context.Context) error {
+			var record Email
+			if err := r.collection.FindOneAndUpdate(ctx, bson.M{
+				"state":   email.StateScheduled,
+				"send_at": bson.M{"$lte": at},
+			}, bson.M{
+				"$set": bson.M{"state": email.StateQueued, "updated_at": time.Now()},
+			}).Decode(&record); err != nil {
+				return err
+			}
+
+			// the document comes back as it was, still Scheduled
+			scheduled = r.toDomain(record.public())
+			if err := scheduled.Transition(email.StateQueued); err != nil {
+				return err
+			}
+
+			return r.announce(ctx, *scheduled)
+		})
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return released, nil
+		}
+
+		if err != nil {
+			err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			r.log.Error("failed to release scheduled emails",
+				zap.String("operation", "release"),
+				zap.String("trace_id", traceID),
+				zap.Error(err),
+			)
+			return released, err
+		}
+
+		released = append(released, scheduled)
+	}
+}
+
+// UpdateFrom implements email.Repo.
+func (r *repository) UpdateFrom(ctx context.Context, payload email.Domain, from email.State) error {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.email.update_from", trace.WithAttributes(
+		attribute.String("operation", "update_from"),
+		attribute.String("payload", fmt.Sprintf("%+v", payload.Public())),
+		attribute.String("from", string(from)),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	err := outbox.Transaction(ctx, r.collection, func(ctx context.Context) error {
+		res, err := r.collection.UpdateOne(ctx, bson.M{"_id": payload.ID(), "state": from}, bson.M{
+			"$set":   newCollection(payload),
+			"$unset": bson.M{"claimed_until": "", "claim_token": ""},
+		})
+		if err != nil {
+			return err
+		}
+
+		if res.MatchedCount == 0 {
+			return errStateChanged
+		}
+
+		return r.announce(ctx, payload)
+	})
+	if err != nil {
+		if errors.Is(err, errStateChanged) {
+			err = ose_error.New(ose_error.ErrConflict, fmt.Sprintf("email is no longer %s", from), traceID)
+		} else {
+			err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to update email",
+			zap.String("operation", "update_from"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	r.log.Info("update process complete successfully",
+		zap.String("operation", "update_from"),
+		zap.String("trace_id", traceID),
+		zap.Any("payload", payload.Public()),
+	)
+
+	return nil
+}
+
+// Settle implements email.Repo.
+func (r *repository) Settle(ctx context.Context, payload email.Domain, lease email.Lease) error {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.email.settle", trace.WithAttributes(
+		attribute.String("operation", "settle"),
+		attribute.String("payload", fmt.Sprintf("%+v", payload.Public())),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	filter := bson.M{
+		"_id":         payload.ID(),
+		"state":       email.StateSending,
+		"claim_token": lease.Token,
+	}
+	err := outbox.Transaction(ctx, r.collection, func(ctx context.Context) error {
+		res, err := r.collection.UpdateOne(ctx, filter, bson.M{
+			"$set":   newCollection(payload),
+			"$unset": bson.M{"claimed_until": "", "claim_token": ""},
+		})
+		if err != nil {
+			return err
+		}
+
+		if res.MatchedCount == 0 {
+			return errStateChanged
+		}
+
+		return r.announce(ctx, payload)
+	})
+	if err != nil {
+		if errors.Is(err, errStateChanged) {
+			err = ose_error.New(ose_error.ErrConflict, "email lease was lost to another dispatcher", traceID)
+		} else {
+			err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to settle email",
+			zap.String("operation", "settle"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	r.log.Info("settle process complete successfully",
+		zap.String("operation", "settle"),
+		zap.String("trace_id", traceID),
+		zap.Any("payload", payload.Public()),
+	)
+
+	return nil
+}
+
 func (r *repository) toDomain(payload email.Public) *email.Domain {
 	result, _ := r.bs.Email.Existing(*payload.Params())
 	return result
 }
 
 func NewRepository(db *mongodb.Client, log logger.Logger, tracer tracing.Tracer, bs business.Domain) email.Repo {
+	collection := db.Collection("emails")
+	if _, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "state", Value: 1}, {Key: "created_at", Value: 1}},
+	}); err != nil {
+		log.Error("failed to ensure email queue index", zap.Error(err))
+	}
+
+	if _, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "idempotency_key", Value: 1}},
+		Options: options.Index().SetUnique(true).SetSparse(true),
+	}); err != nil {
+		log.Error("failed to ensure email idempotency index", zap.Error(err))
+	}
+
+	// bounces and replies may quote the Message-ID of any attempt, not just the latest
+	if _, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "attempts.message_id", Value: 1}},
+	}); err != nil {
+		log.Error("failed to ensure email message id index", zap.Error(err))
+	}
+
+	// provider webhooks identify the attempt by the id the provider gave it
+	if _, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "attempts.provider_id", Value: 1}},
+		Options: options.Index().SetSparse(true),
+	}); err != nil {
+		log.Error("failed to ensure email provider id index", zap.Error(err))
+	}
+
 	return &repository{
-		log:        log,
-		tracer:     tracer,
-		bs:         bs,
-		collection: db.Collection("emails"),
+		log:           log,
+		tracer:        tracer,
+		bs:            bs,
+		collection:    collection,
+		blobs:         db.Collection("email_blobs"),
+		outbox:        db.Collection(outbox.Name),
+		notifications: db.Collection(subscription.Notifications),
 	}
 }
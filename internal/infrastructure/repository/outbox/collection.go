@@ -0,0 +1,43 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/ose-micro/postman/internal/business/event"
+)
+
+type Message struct {
+	Id           string     `bson:"_id"`
+	Subject      string     `bson:"subject"`
+	Payload      string     `bson:"payload"`
+	Attempts     int32      `bson:"attempts"`
+	LastError    string     `bson:"last_error,omitempty"`
+	CreatedAt    time.Time  `bson:"created_at"`
+	DispatchedAt *time.Time `bson:"dispatched_at"`
+	// ClaimedUntil is the relay lease; it is never set through the domain.
+	ClaimedUntil *time.Time `bson:"claimed_until,omitempty"`
+}
+
+func (m Message) public() event.Message {
+	return event.Message{
+		Id:           m.Id,
+		Subject:      m.Subject,
+		Payload:      m.Payload,
+		Attempts:     m.Attempts,
+		LastError:    m.LastError,
+		CreatedAt:    m.CreatedAt,
+		DispatchedAt: m.DispatchedAt,
+	}
+}
+
+func newMessage(message event.Message) Message {
+	return Message{
+		Id:           message.Id,
+		Subject:      message.Subject,
+		Payload:      message.Payload,
+		Attempts:     message.Attempts,
+		LastError:    message.LastError,
+		CreatedAt:    message.CreatedAt,
+		DispatchedAt: message.DispatchedAt,
+	}
+}
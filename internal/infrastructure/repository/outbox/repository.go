@@ -0,0 +1,156 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	ose_error "github.com/ose-micro/error"
+	mongodb "github.com/ose-micro/mongo"
+	"github.com/ose-micro/postman/internal/business/event"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// retention is how long dispatched messages are kept for inspection.
+const retention = 7 * 24 * time.Hour
+
+type repository struct {
+	collection *mongo.Collection
+	log        logger.Logger
+	tracer     tracing.Tracer
+}
+
+// Claim implements event.Outbox.
+func (r *repository) Claim(ctx context.Context, lease time.Duration) (*event.Message, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.outbox.claim", trace.WithAttributes(
+		attribute.String("operation", "claim"),
+		attribute.String("lease", lease.String()),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	now := time.Now()
+	filter := bson.M{
+		"dispatched_at": nil,
+		"$or": bson.A{
+			bson.M{"claimed_until": nil},
+			bson.M{"claimed_until": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{"claimed_until": now.Add(lease)},
+		"$inc": bson.M{"attempts": 1},
+	}
+	// oldest first; a failed message keeps its lease, so later ones may overtake it
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var record Message
+	if err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&record); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to claim outbox message",
+			zap.String("operation", "claim"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	message := record.public()
+	return &message, nil
+}
+
+// Dispatched implements event.Outbox.
+func (r *repository) Dispatched(ctx context.Context, id string) error {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.outbox.dispatched", trace.WithAttributes(
+		attribute.String("operation", "dispatched"),
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set":   bson.M{"dispatched_at": time.Now()},
+		"$unset": bson.M{"claimed_until": "", "last_error": ""},
+	}); err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to mark outbox message dispatched",
+			zap.String("operation", "dispatched"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// Failed implements event.Outbox.
+func (r *repository) Failed(ctx context.Context, id, reason string) error {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.outbox.failed", trace.WithAttributes(
+		attribute.String("operation", "failed"),
+		attribute.String("id", id),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// the lease is kept, so the message waits it out before the next attempt
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set": bson.M{"last_error": reason},
+	}); err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to record outbox failure",
+			zap.String("operation", "failed"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+func NewRepository(db *mongodb.Client, log logger.Logger, tracer tracing.Tracer) event.Outbox {
+	collection := db.Collection(Name)
+	if _, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "dispatched_at", Value: 1}, {Key: "created_at", Value: 1}},
+	}); err != nil {
+		log.Error("failed to ensure outbox queue index", zap.Error(err))
+	}
+
+	// only dispatched messages carry a date in dispatched_at, so pending ones never expire
+	if _, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "dispatched_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(retention.Seconds())).SetName("dispatched_at_ttl"),
+	}); err != nil {
+		log.Error("failed to ensure outbox retention index", zap.Error(err))
+	}
+
+	return &repository{
+		log:        log,
+		tracer:     tracer,
+		collection: collection,
+	}
+}
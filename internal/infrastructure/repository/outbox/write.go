@@ -0,0 +1,75 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ose-micro/postman/internal/business/event"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Name is the collection the outbox lives in.
+const Name = "outbox"
+
+// ErrNoTransactions is returned by Supported when MongoDB cannot run transactions.
+var ErrNoTransactions = errors.New("mongodb does not support transactions: run it as a replica set " +
+	"(a single node one is enough: start mongod with --replSet and run rs.initiate() once) or connect through mongos")
+
+// Supported returns ErrNoTransactions unless the server behind collection is a
+// replica set member or a mongos, the deployments transactions run on. Check
+// it at startup: on a standalone server every save would fail instead.
+func Supported(ctx context.Context, collection *mongo.Collection) error {
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	if err := collection.Database().RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		return err
+	}
+
+	if hello.SetName == "" && hello.Msg != "isdbgrid" {
+		return ErrNoTransactions
+	}
+
+	return nil
+}
+
+// Transaction runs fn in a transaction on the database of collection; every
+// write fn makes with the context it is given commits or aborts together.
+// MongoDB only supports transactions on replica sets; a single node one is
+// enough. fn may be run more than once on transient errors.
+func Transaction(ctx context.Context, collection *mongo.Collection, fn func(ctx context.Context) error) error {
+	session, err := collection.Database().Client().StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(ctx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(ctx)
+	})
+
+	return err
+}
+
+// Write adds events to the outbox. Call it inside the Transaction that saves
+// the change the events describe.
+func Write(ctx context.Context, collection *mongo.Collection, events ...event.Envelope) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	documents := make([]interface{}, 0, len(events))
+	for _, envelope := range events {
+		message, err := event.NewMessage(envelope)
+		if err != nil {
+			return err
+		}
+
+		documents = append(documents, newMessage(message))
+	}
+
+	_, err := collection.InsertMany(ctx, documents)
+	return err
+}
@@ -1,24 +1,57 @@
 package repository
 
 import (
+	"context"
+	"time"
+
 	"github.com/ose-micro/core/logger"
 	"github.com/ose-micro/core/tracing"
 	mongodb "github.com/ose-micro/mongo"
 	"github.com/ose-micro/postman/internal/business"
+	deadletterDomain "github.com/ose-micro/postman/internal/business/deadletter"
 	emailDomain "github.com/ose-micro/postman/internal/business/email"
+	eventDomain "github.com/ose-micro/postman/internal/business/event"
+	subscriptionDomain "github.com/ose-micro/postman/internal/business/subscription"
+	suppressionDomain "github.com/ose-micro/postman/internal/business/suppression"
 	templateDomain "github.com/ose-micro/postman/internal/business/template"
+	"github.com/ose-micro/postman/internal/infrastructure/repository/deadletter"
 	"github.com/ose-micro/postman/internal/infrastructure/repository/email"
+	"github.com/ose-micro/postman/internal/infrastructure/repository/outbox"
+	"github.com/ose-micro/postman/internal/infrastructure/repository/subscription"
+	"github.com/ose-micro/postman/internal/infrastructure/repository/suppression"
 	"github.com/ose-micro/postman/internal/infrastructure/repository/template"
+	"go.uber.org/zap"
 )
 
+// supportTimeout bounds the startup check that MongoDB runs transactions.
+const supportTimeout = 10 * time.Second
+
 type Repository struct {
-	Template templateDomain.Repo
-	Email    emailDomain.Repo
+	Template     templateDomain.Repo
+	Email        emailDomain.Repo
+	Suppression  suppressionDomain.Repo
+	Subscription subscriptionDomain.Repo
+	Outbox       eventDomain.Outbox
+	DeadLetter   deadletterDomain.Repo
 }
 
-func InjectRepository(db *mongodb.Client, bs business.Domain, log logger.Logger, tracer tracing.Tracer) Repository {
-	return Repository{
-		Template: template.NewRepository(db, log, tracer, bs),
-		Email:    email.NewRepository(db, log, tracer, bs),
+// InjectRepository fails when MongoDB cannot run the transactions every save
+// relies on, so a misconfigured deployment stops at startup.
+func InjectRepository(db *mongodb.Client, bs business.Domain, log logger.Logger, tracer tracing.Tracer) (Repository, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), supportTimeout)
+	defer cancel()
+
+	if err := outbox.Supported(ctx, db.Collection(outbox.Name)); err != nil {
+		log.Error("mongodb transactions unavailable", zap.Error(err))
+		return Repository{}, err
 	}
+
+	return Repository{
+		Template:     template.NewRepository(db, log, tracer, bs),
+		Email:        email.NewRepository(db, log, tracer, bs),
+		Suppression:  suppression.NewRepository(db, log, tracer, bs),
+		Subscription: subscription.NewRepository(db, log, tracer, bs),
+		Outbox:       outbox.NewRepository(db, log, tracer),
+		DeadLetter:   deadletter.NewRepository(db, log, tracer),
+	}, nil
 }
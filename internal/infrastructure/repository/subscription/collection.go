@@ -0,0 +1,149 @@
+package subscription
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/postman/internal/business/subscription"
+)
+
+type Collection struct {
+	Id        string     `bson:"_id"`
+	Url       string     `bson:"url"`
+	Secret    string     `bson:"secret"`
+	Filter    []string   `bson:"filter,omitempty"`
+	Tenant    string     `bson:"tenant"`
+	Version   int32      `bson:"version"`
+	CreatedAt time.Time  `bson:"created_at"`
+	UpdatedAt time.Time  `bson:"updated_at"`
+	DeletedAt *time.Time `bson:"deleted_at"`
+}
+
+func (c Collection) public() subscription.Public {
+	return subscription.Public{
+		Id:        c.Id,
+		Url:       c.Url,
+		Secret:    c.Secret,
+		Filter:    c.Filter,
+		Tenant:    c.Tenant,
+		Version:   c.Version,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+		DeletedAt: c.DeletedAt,
+	}
+}
+
+func newCollection(params subscription.Domain) Collection {
+	return Collection{
+		Id:        params.ID(),
+		Url:       params.Url(),
+		Secret:    params.Secret(),
+		Filter:    params.Filter(),
+		Tenant:    params.Tenant(),
+		Version:   params.Version(),
+		CreatedAt: params.CreatedAt(),
+		UpdatedAt: params.UpdatedAt(),
+		DeletedAt: params.DeletedAt(),
+	}
+}
+
+type Delivery struct {
+	Id             string                     `bson:"_id"`
+	SubscriptionId string                     `bson:"subscription_id"`
+	EmailId        string                     `bson:"email_id"`
+	Event          string                     `bson:"event"`
+	Payload        string                     `bson:"payload"`
+	State          subscription.DeliveryState `bson:"state"`
+	Attempts       int32                      `bson:"attempts"`
+	Status         int32                      `bson:"status"`
+	LastError      string                     `bson:"last_error,omitempty"`
+	NextAttemptAt  *time.Time                 `bson:"next_attempt_at"`
+	DeliveredAt    *time.Time                 `bson:"delivered_at,omitempty"`
+	CreatedAt      time.Time                  `bson:"created_at"`
+	UpdatedAt      time.Time                  `bson:"updated_at"`
+	// ClaimedUntil is the notifier lease; it is never set through the domain.
+	ClaimedUntil *time.Time `bson:"claimed_until,omitempty"`
+}
+
+func (d Delivery) public() subscription.Delivery {
+	return subscription.Delivery{
+		Id:             d.Id,
+		SubscriptionId: d.SubscriptionId,
+		EmailId:        d.EmailId,
+		Event:          d.Event,
+		Payload:        d.Payload,
+		State:          d.State,
+		Attempts:       d.Attempts,
+		Status:         d.Status,
+		LastError:      d.LastError,
+		NextAttemptAt:  d.NextAttemptAt,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
+
+func newDelivery(delivery subscription.Delivery) Delivery {
+	return Delivery{
+		Id:             delivery.Id,
+		SubscriptionId: delivery.SubscriptionId,
+		EmailId:        delivery.EmailId,
+		Event:          delivery.Event,
+		Payload:        delivery.Payload,
+		State:          delivery.State,
+		Attempts:       delivery.Attempts,
+		Status:         delivery.Status,
+		LastError:      delivery.LastError,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
+
+type Notification struct {
+	Id      string `bson:"_id"`
+	EmailId string `bson:"email_id"`
+	// Payload is the JSON encoded email and changes.
+	Payload   string    `bson:"payload"`
+	CreatedAt time.Time `bson:"created_at"`
+	// ClaimedUntil is the notifier lease; it is never set through the domain.
+	ClaimedUntil *time.Time `bson:"claimed_until,omitempty"`
+}
+
+type notificationPayload struct {
+	Email   subscription.PayloadEmail `json:"email"`
+	Changes []email.Change            `json:"changes"`
+}
+
+func (n Notification) public() (subscription.Notification, error) {
+	var payload notificationPayload
+	if err := json.Unmarshal([]byte(n.Payload), &payload); err != nil {
+		return subscription.Notification{}, err
+	}
+
+	return subscription.Notification{
+		Id:        n.Id,
+		Email:     payload.Email,
+		Changes:   payload.Changes,
+		CreatedAt: n.CreatedAt,
+	}, nil
+}
+
+func newNotification(notification subscription.Notification) (Notification, error) {
+	payload, err := json.Marshal(notificationPayload{
+		Email:   notification.Email,
+		Changes: notification.Changes,
+	})
+	if err != nil {
+		return Notification{}, err
+	}
+
+	return Notification{
+		Id:        notification.Id,
+		EmailId:   notification.Email.Id,
+		Payload:   string(payload),
+		CreatedAt: notification.CreatedAt,
+	}, nil
+}
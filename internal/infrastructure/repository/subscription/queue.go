@@ -0,0 +1,28 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/ose-micro/postman/internal/business/email"
+	"github.com/ose-micro/postman/internal/business/subscription"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Notifications is the collection queued notifications live in.
+const Notifications = "webhook_notifications"
+
+// Queue adds a notification of changes to record for the subscribers. Call it
+// inside the outbox.Transaction that saves the changes.
+func Queue(ctx context.Context, collection *mongo.Collection, record email.Public, changes []email.Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	document, err := newNotification(subscription.NewNotification(record, changes))
+	if err != nil {
+		return err
+	}
+
+	_, err = collection.InsertOne(ctx, document)
+	return err
+}
@@ -0,0 +1,548 @@
+package subscription
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ose-micro/common"
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	ose_error "github.com/ose-micro/error"
+	mongodb "github.com/ose-micro/mongo"
+	"github.com/ose-micro/postman/internal/business"
+	"github.com/ose-micro/postman/internal/business/subscription"
+	"github.com/ose-micro/postman/internal/infrastructure/repository/outbox"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// errNotificationGone aborts a fan out whose notification another worker took.
+var errNotificationGone = errors.New("notification gone")
+
+type repository struct {
+	collection    *mongo.Collection
+	deliveries    *mongo.Collection
+	notifications *mongo.Collection
+	log           logger.Logger
+	tracer        tracing.Tracer
+	bs            business.Domain
+}
+
+func (r *repository) ReadOne(ctx context.Context, request dto.Request) (*subscription.Domain, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.subscription.read_one", trace.WithAttributes(
+		attribute.String("operation", "read_one"),
+		attribute.String("dto", fmt.Sprintf("%v", request))),
+	)
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	res, err := r.Read(ctx, request)
+	if err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to repository res",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read_one"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	raw, ok := res["one"]
+	if !ok {
+		return nil, ose_error.New(ose_error.ErrNotFound, "subscription not found")
+	}
+
+	var records []subscription.Public
+
+	if err := common.JsonToAny(raw, &records); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to repository res",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read_one"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		err := ose_error.New(ose_error.ErrNotFound, "subscription not found", traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to repository res",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read_one"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return r.toDomain(records[0]), nil
+}
+
+// Create implements subscription.Repo.
+func (r *repository) Create(ctx context.Context, payload subscription.Domain) error {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.subscription.create", trace.WithAttributes(
+		attribute.String("operation", "create"),
+		attribute.String("id", payload.ID()),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	record := newCollection(payload)
+	if _, err := r.collection.InsertOne(ctx, record); err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to create in mongo",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "create"),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	// the secret is kept out of the logs
+	r.log.Info("create process complete successfully",
+		zap.String("operation", "create"),
+		zap.String("trace_id", traceId),
+		zap.String("id", payload.ID()),
+		zap.String("url", payload.Url()),
+	)
+	return nil
+}
+
+// Delete implements subscription.Repo.
+func (r *repository) Delete(ctx context.Context, payload subscription.Domain) error {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.subscription.delete", trace.WithAttributes(
+		attribute.String("operation", "delete"),
+		attribute.String("id", payload.ID()),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	filter := bson.M{"_id": payload.ID()}
+	if _, err := r.collection.DeleteOne(ctx, filter); err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to delete in mongo",
+			zap.String("operation", "delete"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	r.log.Info("delete process completed successfully",
+		zap.String("operation", "delete"),
+		zap.String("trace_id", traceID),
+		zap.String("id", payload.ID()),
+	)
+
+	return nil
+}
+
+// Read implements subscription.Repo.
+func (r *repository) Read(ctx context.Context, request dto.Request) (map[string]any, error) {
+	return r.read(ctx, r.collection, "subscription", subscription.Public{}, request)
+}
+
+// ReadDeliveries implements subscription.Repo.
+func (r *repository) ReadDeliveries(ctx context.Context, request dto.Request) (map[string]any, error) {
+	return r.read(ctx, r.deliveries, "subscription_delivery", subscription.Delivery{}, request)
+}
+
+func (r *repository) read(ctx context.Context, collection *mongo.Collection, name string, kind any,
+	request dto.Request) (map[string]any, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository."+name+".read", trace.WithAttributes(
+		attribute.String("operation", "read"),
+		attribute.String("payload", fmt.Sprintf("%+v", request)),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+	mongodb.RegisterType(name, kind)
+	typeHints := map[string]string{}
+
+	for _, v := range request.Queries {
+		typeHints[v.Name] = name
+	}
+
+	res, err := mongodb.RunFaceted(ctx, collection, request)
+	if err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.log.Error("Failed to fetch by request",
+			zap.String("operation", "read"),
+			zap.String("collection", collection.Name()),
+			zap.String("trace_id", traceID),
+			zap.Any("payload", request),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	records, err := mongodb.CastFacetedResult(res, typeHints)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("Failed to cast faceted result",
+			zap.String("operation", "read"),
+			zap.String("collection", collection.Name()),
+			zap.String("trace_id", traceID),
+			zap.Any("payload", request),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// Match implements subscription.Repo.
+func (r *repository) Match(ctx context.Context, event, tenant string) ([]subscription.Domain, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.subscription.match", trace.WithAttributes(
+		attribute.String("operation", "match"),
+		attribute.String("event", event),
+		attribute.String("tenant", tenant),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// a missing or empty filter subscribes to every event
+	cursor, err := r.collection.Find(ctx, bson.M{
+		"tenant": bson.M{"$in": bson.A{"", tenant}},
+		"$or": bson.A{
+			bson.M{"filter": nil},
+			bson.M{"filter": bson.A{}},
+			bson.M{"filter": event},
+		},
+	})
+	if err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to match subscriptions",
+			zap.String("operation", "match"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	var records []Collection
+	if err := cursor.All(ctx, &records); err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to decode subscriptions",
+			zap.String("operation", "match"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	subscriptions := make([]subscription.Domain, 0, len(records))
+	for _, record := range records {
+		subscriptions = append(subscriptions, *r.toDomain(record.public()))
+	}
+
+	return subscriptions, nil
+}
+
+// ClaimNotification implements subscription.Repo.
+func (r *repository) ClaimNotification(ctx context.Context, lease time.Duration) (*subscription.Notification, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.subscription.claim_notification", trace.WithAttributes(
+		attribute.String("operation", "claim_notification"),
+		attribute.String("lease", lease.String()),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	now := time.Now()
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"claimed_until": nil},
+			bson.M{"claimed_until": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"claimed_until": now.Add(lease)}}
+	// oldest first, so subscribers hear about the changes of an email in order
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var record Notification
+	if err := r.notifications.FindOneAndUpdate(ctx, filter, update, opts).Decode(&record); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to claim notification",
+			zap.String("operation", "claim_notification"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	notification, err := record.public()
+	if err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to decode notification",
+			zap.String("operation", "claim_notification"),
+			zap.String("trace_id", traceID),
+			zap.String("id", record.Id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &notification, nil
+}
+
+// Fanout implements subscription.Repo.
+func (r *repository) Fanout(ctx context.Context, id string, deliveries []subscription.Delivery) error {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.subscription.fanout", trace.WithAttributes(
+		attribute.String("operation", "fanout"),
+		attribute.String("id", id),
+		attribute.Int("count", len(deliveries)),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	err := outbox.Transaction(ctx, r.notifications, func(ctx context.Context) error {
+		res, err := r.notifications.DeleteOne(ctx, bson.M{"_id": id})
+		if err != nil {
+			return err
+		}
+
+		// a worker whose lease ran out must not queue the callbacks twice
+		if res.DeletedCount == 0 {
+			return errNotificationGone
+		}
+
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		documents := make([]any, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			documents = append(documents, newDelivery(delivery))
+		}
+
+		_, err = r.deliveries.InsertMany(ctx, documents)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, errNotificationGone) {
+			err = ose_error.New(ose_error.ErrConflict, "notification was fanned out by another worker", traceID)
+		} else {
+			err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to fan out notification",
+			zap.String("operation", "fanout"),
+			zap.String("trace_id", traceID),
+			zap.String("id", id),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// ClaimDelivery implements subscription.Repo.
+func (r *repository) ClaimDelivery(ctx context.Context, lease time.Duration) (*subscription.Delivery, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.subscription.claim_delivery", trace.WithAttributes(
+		attribute.String("operation", "claim_delivery"),
+		attribute.String("lease", lease.String()),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	now := time.Now()
+	filter := bson.M{
+		"state":           subscription.DeliveryPending,
+		"next_attempt_at": bson.M{"$lte": now},
+		"$or": bson.A{
+			bson.M{"claimed_until": nil},
+			bson.M{"claimed_until": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"claimed_until": now.Add(lease)}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "next_attempt_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var record Delivery
+	if err := r.deliveries.FindOneAndUpdate(ctx, filter, update, opts).Decode(&record); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to claim delivery",
+			zap.String("operation", "claim_delivery"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	delivery := record.public()
+	return &delivery, nil
+}
+
+// UpdateDelivery implements subscription.Repo.
+func (r *repository) UpdateDelivery(ctx context.Context, delivery subscription.Delivery) error {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.subscription.update_delivery", trace.WithAttributes(
+		attribute.String("operation", "update_delivery"),
+		attribute.String("id", delivery.Id),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// saving a delivery always releases the notifier lease
+	if _, err := r.deliveries.UpdateOne(ctx, bson.M{"_id": delivery.Id}, bson.M{
+		"$set":   newDelivery(delivery),
+		"$unset": bson.M{"claimed_until": ""},
+	}); err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to update delivery",
+			zap.String("operation", "update_delivery"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// Replay implements subscription.Repo.
+func (r *repository) Replay(ctx context.Context, id, subscriptionId string) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.subscription.replay", trace.WithAttributes(
+		attribute.String("operation", "replay"),
+		attribute.String("id", id),
+		attribute.String("subscription_id", subscriptionId),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	// a single delivery is replayed whatever its state; a subscription only
+	// replays what failed, so delivered callbacks are not sent twice
+	filter := bson.M{"_id": id}
+	if id == "" {
+		filter = bson.M{"subscription_id": subscriptionId, "state": subscription.DeliveryFailed}
+	}
+
+	now := time.Now()
+	res, err := r.deliveries.UpdateMany(ctx, filter, bson.M{
+		"$set": bson.M{
+			"state":           subscription.DeliveryPending,
+			"attempts":        0,
+			"next_attempt_at": now,
+			"updated_at":      now,
+		},
+		"$unset": bson.M{"claimed_until": ""},
+	})
+	if err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to replay deliveries",
+			zap.String("operation", "replay"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return 0, err
+	}
+
+	r.log.Info("replay process complete successfully",
+		zap.String("operation", "replay"),
+		zap.String("trace_id", traceID),
+		zap.Int64("replayed", res.ModifiedCount),
+	)
+
+	return res.ModifiedCount, nil
+}
+
+func (r *repository) toDomain(payload subscription.Public) *subscription.Domain {
+	result, _ := r.bs.Subscription.Existing(*payload.Params())
+	return result
+}
+
+func NewRepository(db *mongodb.Client, log logger.Logger, tracer tracing.Tracer, bs business.Domain) subscription.Repo {
+	collection := db.Collection("webhook_subscriptions")
+	if _, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "tenant", Value: 1}},
+	}); err != nil {
+		log.Error("failed to ensure subscription tenant index", zap.Error(err))
+	}
+
+	deliveries := db.Collection("webhook_deliveries")
+	if _, err := deliveries.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "state", Value: 1}, {Key: "next_attempt_at", Value: 1}},
+	}); err != nil {
+		log.Error("failed to ensure delivery queue index", zap.Error(err))
+	}
+
+	if _, err := deliveries.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "subscription_id", Value: 1}, {Key: "created_at", Value: -1}},
+	}); err != nil {
+		log.Error("failed to ensure delivery log index", zap.Error(err))
+	}
+
+	notifications := db.Collection(Notifications)
+	if _, err := notifications.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "created_at", Value: 1}},
+	}); err != nil {
+		log.Error("failed to ensure notification queue index", zap.Error(err))
+	}
+
+	return &repository{
+		log:           log,
+		tracer:        tracer,
+		bs:            bs,
+		collection:    collection,
+		deliveries:    deliveries,
+		notifications: notifications,
+	}
+}
@@ -0,0 +1,53 @@
+package suppression
+
+import (
+	"time"
+
+	"github.com/ose-micro/postman/internal/business/suppression"
+)
+
+type Collection struct {
+	Id        string             `bson:"_id"`
+	Address   string             `bson:"address"`
+	Reason    suppression.Reason `bson:"reason"`
+	Source    string             `bson:"source"`
+	Category  string             `bson:"category"`
+	Note      string             `bson:"note,omitempty"`
+	ExpiresAt *time.Time         `bson:"expires_at,omitempty"`
+	Version   int32              `bson:"version"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+	DeletedAt *time.Time         `bson:"deleted_at"`
+}
+
+func (c Collection) public() suppression.Public {
+	return suppression.Public{
+		Id:        c.Id,
+		Address:   c.Address,
+		Reason:    c.Reason,
+		Source:    c.Source,
+		Category:  c.Category,
+		Note:      c.Note,
+		ExpiresAt: c.ExpiresAt,
+		Version:   c.Version,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+		DeletedAt: c.DeletedAt,
+	}
+}
+
+func newCollection(params suppression.Domain) Collection {
+	return Collection{
+		Id:        params.ID(),
+		Address:   params.Address(),
+		Reason:    params.Reason(),
+		Source:    params.Source(),
+		Category:  params.Category(),
+		Note:      params.Note(),
+		ExpiresAt: params.ExpiresAt(),
+		Version:   params.Version(),
+		CreatedAt: params.CreatedAt(),
+		UpdatedAt: params.UpdatedAt(),
+		DeletedAt: params.DeletedAt(),
+	}
+}
@@ -0,0 +1,335 @@
+package suppression
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ose-micro/common"
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/core/logger"
+	"github.com/ose-micro/core/tracing"
+	ose_error "github.com/ose-micro/error"
+	mongodb "github.com/ose-micro/mongo"
+	"github.com/ose-micro/postman/internal/business"
+	"github.com/ose-micro/postman/internal/business/suppression"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type repository struct {
+	collection *mongo.Collection
+	log        logger.Logger
+	tracer     tracing.Tracer
+	bs         business.Domain
+}
+
+func (r *repository) ReadOne(ctx context.Context, request dto.Request) (*suppression.Domain, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.suppression.read_one", trace.WithAttributes(
+		attribute.String("operation", "read_one"),
+		attribute.String("dto", fmt.Sprintf("%v", request))),
+	)
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	res, err := r.Read(ctx, request)
+	if err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to repository res",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read_one"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	raw, ok := res["one"]
+	if !ok {
+		return nil, ose_error.New(ose_error.ErrNotFound, "suppression not found")
+	}
+
+	var records []suppression.Public
+
+	if err := common.JsonToAny(raw, &records); err != nil {
+		err := ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to repository res",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read_one"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		err := ose_error.New(ose_error.ErrNotFound, "suppression not found", traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to repository res",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read_one"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return r.toDomain(records[0]), nil
+}
+
+// Create implements suppression.Repo.
+func (r *repository) Create(ctx context.Context, payload suppression.Domain) error {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.suppression.create", trace.WithAttributes(
+		attribute.String("operation", "create"),
+		attribute.String("payload", fmt.Sprintf("%v", payload.Public())),
+	))
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	record := newCollection(payload)
+	if _, err := r.collection.InsertOne(ctx, record); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			err = ose_error.Wrap(err, ose_error.ErrConflict, "address already suppressed in this category", traceId)
+		} else {
+			err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to create in mongo",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "create"),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	r.log.Info("create process complete successfully",
+		zap.String("operation", "create"),
+		zap.String("trace_id", traceId),
+		zap.Any("payload", payload.Public()),
+	)
+	return nil
+}
+
+// Delete implements suppression.Repo.
+func (r *repository) Delete(ctx context.Context, payload suppression.Domain) error {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.suppression.delete", trace.WithAttributes(
+		attribute.String("operation", "delete"),
+		attribute.String("payload", fmt.Sprintf("%+v", payload.Public())),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	filter := bson.M{"_id": payload.ID()}
+	if _, err := r.collection.DeleteOne(ctx, filter); err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to delete in mongo",
+			zap.String("operation", "delete"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	r.log.Info("delete process completed successfully",
+		zap.String("operation", "delete"),
+		zap.String("trace_id", traceID),
+		zap.Any("payload", payload.Public()),
+	)
+
+	return nil
+}
+
+// Read implements suppression.Repo.
+func (r *repository) Read(ctx context.Context, request dto.Request) (map[string]any, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.suppression.repository", trace.WithAttributes(
+		attribute.String("operation", "read"),
+		attribute.String("payload", fmt.Sprintf("%+v", request)),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+	mongodb.RegisterType("suppression", suppression.Public{})
+	typeHints := map[string]string{}
+
+	for _, v := range request.Queries {
+		typeHints[v.Name] = "suppression"
+	}
+
+	res, err := mongodb.RunFaceted(ctx, r.collection, request)
+	if err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.log.Error("Failed to fetch suppressions by request",
+			zap.String("operation", "read"),
+			zap.String("trace_id", traceID),
+			zap.Any("payload", request),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	r.log.Info("Read process completed successfully",
+		zap.String("operation", "read"),
+		zap.String("trace_id", traceID),
+		zap.Any("payload", request),
+	)
+
+	records, err := mongodb.CastFacetedResult(res, typeHints)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("Failed to cast faceted result",
+			zap.String("operation", "read"),
+			zap.String("trace_id", traceID),
+			zap.Any("payload", request),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// Update implements suppression.Repo.
+func (r *repository) Update(ctx context.Context, payload suppression.Domain) error {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.suppression.update", trace.WithAttributes(
+		attribute.String("operation", "update"),
+		attribute.String("payload", fmt.Sprintf("%+v", payload.Public())),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	collection := newCollection(payload)
+	filter := bson.M{"_id": payload.ID()}
+
+	update := bson.M{"$set": collection}
+	if payload.ExpiresAt() == nil {
+		update["$unset"] = bson.M{"expires_at": ""}
+	}
+
+	if _, err := r.collection.UpdateOne(ctx, filter, update); err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		r.log.Error("failed to update suppression",
+			zap.String("operation", "update"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	r.log.Info("update process complete successfully",
+		zap.String("operation", "update"),
+		zap.String("trace_id", traceID),
+		zap.Any("payload", payload.Public()),
+	)
+
+	return nil
+}
+
+// Check implements suppression.Repo.
+func (r *repository) Check(ctx context.Context, addresses []string, category string, at time.Time) ([]suppression.Domain, error) {
+	ctx, span := r.tracer.Start(ctx, "infrastructure.repository.suppression.check", trace.WithAttributes(
+		attribute.String("operation", "check"),
+		attribute.StringSlice("addresses", addresses),
+		attribute.String("category", category),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+
+	// the TTL monitor deletes expired entries only about once a minute
+	filter := bson.M{
+		"address":  bson.M{"$in": addresses},
+		"category": bson.M{"$in": bson.A{"", category}},
+		"$or": bson.A{
+			bson.M{"expires_at": nil},
+			bson.M{"expires_at": bson.M{"$gt": at}},
+		},
+	}
+
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to check suppressions",
+			zap.String("operation", "check"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	var records []Collection
+	if err := cursor.All(ctx, &records); err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.log.Error("failed to decode suppressions",
+			zap.String("operation", "check"),
+			zap.String("trace_id", traceID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	suppressions := make([]suppression.Domain, 0, len(records))
+	for _, record := range records {
+		suppressions = append(suppressions, *r.toDomain(record.public()))
+	}
+
+	return suppressions, nil
+}
+
+func (r *repository) toDomain(payload suppression.Public) *suppression.Domain {
+	result, _ := r.bs.Suppression.Existing(*payload.Params())
+	return result
+}
+
+func NewRepository(db *mongodb.Client, log logger.Logger, tracer tracing.Tracer, bs business.Domain) suppression.Repo {
+	collection := db.Collection("suppressions")
+	if _, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "address", Value: 1}, {Key: "category", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}); err != nil {
+		log.Error("failed to ensure suppression address index", zap.Error(err))
+	}
+
+	// expired entries are removed by Mongo itself
+	if _, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	}); err != nil {
+		log.Error("failed to ensure suppression expiry index", zap.Error(err))
+	}
+
+	return &repository{
+		log:        log,
+		tracer:     tracer,
+		bs:         bs,
+		collection: collection,
+	}
+}
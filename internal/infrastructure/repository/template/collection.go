@@ -8,8 +8,10 @@ import (
 
 type Collection struct {
 	Id           string     `bson:"_id"`
+	Key          string     `bson:"key,omitempty"`
 	Subject      string     `bson:"subject"`
-	Content      string     `bson:"content"`
+	HtmlContent  string     `bson:"html_content"`
+	TextContent  string     `bson:"text_content"`
 	Placeholders []string   `bson:"placeholders"`
 	Version      int32      `bson:"version"`
 	CreatedAt    time.Time  `bson:"created_at"`
@@ -20,8 +22,10 @@ type Collection struct {
 func newCollection(params template.Domain) Collection {
 	return Collection{
 		Id:           params.ID(),
+		Key:          params.Key(),
 		Subject:      params.Subject(),
-		Content:      params.Content(),
+		HtmlContent:  params.HtmlContent(),
+		TextContent:  params.TextContent(),
 		Placeholders: params.Placeholders(),
 		Version:      params.Version(),
 		CreatedAt:    params.CreatedAt(),
@@ -2,7 +2,9 @@ package template
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ose-micro/common"
 	"github.com/ose-micro/core/dto"
@@ -11,17 +13,26 @@ import (
 	ose_error "github.com/ose-micro/error"
 	mongodb "github.com/ose-micro/mongo"
 	"github.com/ose-micro/postman/internal/business"
+	"github.com/ose-micro/postman/internal/business/event"
 	"github.com/ose-micro/postman/internal/business/template"
+	"github.com/ose-micro/postman/internal/infrastructure/repository/outbox"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
+// errVersionChanged aborts an update whose head moved past the version the
+// payload was revised from.
+var errVersionChanged = errors.New("template version changed")
+
 type repository struct {
 	collection *mongo.Collection
+	revisions  *mongo.Collection
+	outbox     *mongo.Collection
 	log        logger.Logger
 	tracer     tracing.Tracer
 	bs         business.Domain
@@ -94,8 +105,22 @@ func (c *repository) Create(ctx context.Context, payload template.Domain) error
 	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
 
 	record := newCollection(payload)
-	if _, err := c.collection.InsertOne(ctx, record); err != nil {
-		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+	if err := outbox.Transaction(ctx, c.collection, func(ctx context.Context) error {
+		if _, err := c.collection.InsertOne(ctx, record); err != nil {
+			return err
+		}
+
+		if err := c.saveRevision(ctx, payload.Revision()); err != nil {
+			return err
+		}
+
+		return outbox.Write(ctx, c.outbox, templateEvent(event.TemplateCreated, payload))
+	}); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			err = ose_error.Wrap(err, ose_error.ErrConflict, "template already exist with this key", traceId)
+		} else {
+			err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+		}
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		c.log.Error("failed to create in mongo",
@@ -125,7 +150,13 @@ func (c *repository) Delete(ctx context.Context, payload template.Domain) error
 	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
 
 	filter := bson.M{"_id": payload.ID()}
-	if _, err := c.collection.DeleteOne(ctx, filter); err != nil {
+	if err := outbox.Transaction(ctx, c.collection, func(ctx context.Context) error {
+		if _, err := c.collection.DeleteOne(ctx, filter); err != nil {
+			return err
+		}
+
+		return outbox.Write(ctx, c.outbox, templateEvent(event.TemplateDeleted, payload))
+	}); err != nil {
 		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -199,7 +230,9 @@ func (c *repository) Read(ctx context.Context, request dto.Request) (map[string]
 	return records, nil
 }
 
-// Update implements template.Repository.
+// Update implements template.Repository. The revision and the head are
+// written together, and the head only moves when it is still at the version
+// payload was revised from.
 func (c *repository) Update(ctx context.Context, payload template.Domain) error {
 	ctx, span := c.tracer.Start(ctx, "infrastructure.repository.template.update", trace.WithAttributes(
 		attribute.String("operation", "update"),
@@ -210,12 +243,36 @@ func (c *repository) Update(ctx context.Context, payload template.Domain) error
 	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
 
 	collection := newCollection(payload)
-	filter := bson.M{"_id": payload.ID()}
+	filter := bson.M{"_id": payload.ID(), "version": payload.Version() - 1}
+	update := bson.M{"$set": collection}
+	// the key index is sparse, so a template without a key must not store one at all
+	if collection.Key == "" {
+		update["$unset"] = bson.M{"key": ""}
+	}
+
+	if err := outbox.Transaction(ctx, c.collection, func(ctx context.Context) error {
+		res, err := c.collection.UpdateOne(ctx, filter, update)
+		if err != nil {
+			return err
+		}
+
+		if res.MatchedCount == 0 {
+			return errVersionChanged
+		}
 
-	if _, err := c.collection.UpdateOne(ctx, filter, bson.M{
-		"$set": collection,
+		if err := c.saveRevision(ctx, payload.Revision()); err != nil {
+			return err
+		}
+
+		return outbox.Write(ctx, c.outbox, templateEvent(event.TemplateUpdated, payload))
 	}); err != nil {
-		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		if errors.Is(err, errVersionChanged) {
+			err = ose_error.Wrap(err, ose_error.ErrConflict, "template was changed concurrently, reload and retry", traceID)
+		} else if mongo.IsDuplicateKeyError(err) {
+			err = ose_error.Wrap(err, ose_error.ErrConflict, "template already exist with this key", traceID)
+		} else {
+			err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		}
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 
@@ -236,16 +293,41 @@ func (c *repository) Update(ctx context.Context, payload template.Domain) error
 	return nil
 }
 
+// templateEvent describes the change of payload the repository is saving.
+func templateEvent(subject string, payload template.Domain) event.Envelope {
+	return event.New(subject, time.Now(), event.NewTemplate(payload.Public()))
+}
+
 func (r *repository) toDomain(payload template.Public) *template.Domain {
 	result, _ := r.bs.Template.Existing(*payload.Params())
 	return result
 }
 
 func NewRepository(db *mongodb.Client, log logger.Logger, tracer tracing.Tracer, bs business.Domain) template.Repo {
+	collection := db.Collection("templates")
+	if _, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "key", Value: 1}},
+		Options: options.Index().SetUnique(true).SetSparse(true),
+	}); err != nil {
+		log.Error("failed to ensure template key index", zap.Error(err))
+	}
+
+	revisions := db.Collection("template_revisions")
+	if _, err := revisions.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "template", Value: 1}, {Key: "version", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}); err != nil {
+		log.Error("failed to ensure template revision index", zap.Error(err))
+	}
+
+	backfillRevisions(context.Background(), collection, revisions, db.Collection(migrations), log)
+
 	return &repository{
 		log:        log,
 		tracer:     tracer,
 		bs:         bs,
-		collection: db.Collection("templates"),
+		collection: collection,
+		revisions:  revisions,
+		outbox:     db.Collection(outbox.Name),
 	}
 }
@@ -0,0 +1,247 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ose-micro/common"
+	"github.com/ose-micro/core/dto"
+	"github.com/ose-micro/core/logger"
+	ose_error "github.com/ose-micro/error"
+	mongodb "github.com/ose-micro/mongo"
+	"github.com/ose-micro/postman/internal/business/template"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type RevisionCollection struct {
+	Id           string    `bson:"_id"`
+	Template     string    `bson:"template"`
+	Version      int32     `bson:"version"`
+	Subject      string    `bson:"subject"`
+	HtmlContent  string    `bson:"html_content"`
+	TextContent  string    `bson:"text_content"`
+	Placeholders []string  `bson:"placeholders"`
+	CreatedAt    time.Time `bson:"created_at"`
+}
+
+func newRevisionCollection(params template.Revision) RevisionCollection {
+	return RevisionCollection{
+		Id:           params.Id,
+		Template:     params.Template,
+		Version:      params.Version,
+		Subject:      params.Subject,
+		HtmlContent:  params.HtmlContent,
+		TextContent:  params.TextContent,
+		Placeholders: params.Placeholders,
+		CreatedAt:    params.CreatedAt,
+	}
+}
+
+// saveRevision writes payload into the revision history. It must run inside
+// the transaction that moves the head to payload.Version, which makes any
+// revision already stored at that version an orphan of an earlier failed
+// write, so it is overwritten rather than reported as a conflict.
+func (c *repository) saveRevision(ctx context.Context, payload template.Revision) error {
+	record := newRevisionCollection(payload)
+	_, err := c.revisions.ReplaceOne(ctx, bson.M{"_id": record.Id}, record, options.Replace().SetUpsert(true))
+
+	return err
+}
+
+const (
+	// migrations holds a marker per one-off data migration that has completed.
+	migrations = "migrations"
+	// backfillMarker marks the revision backfill done, so later starts skip it.
+	backfillMarker = "template_revisions_backfill"
+	// backfillTimeout bounds the backfill so a large collection cannot hold up
+	// startup; an unfinished backfill picks up where it left off on the next one.
+	backfillTimeout = 30 * time.Second
+)
+
+// backfillRevisions records the current head of every template that has no
+// revision at its version yet, so templates written before revisions existed
+// can be listed and rolled back like any other. It runs until it completes
+// once without error, which is then recorded in markers.
+func backfillRevisions(ctx context.Context, collection, revisions, markers *mongo.Collection, log logger.Logger) {
+	ctx, cancel := context.WithTimeout(ctx, backfillTimeout)
+	defer cancel()
+
+	done, err := markers.CountDocuments(ctx, bson.M{"_id": backfillMarker})
+	if err != nil {
+		log.Error("failed to check template revision backfill", zap.Error(err))
+		return
+	}
+	if done > 0 {
+		return
+	}
+
+	cursor, err := collection.Find(ctx, bson.M{})
+	if err != nil {
+		log.Error("failed to backfill template revisions", zap.Error(err))
+		return
+	}
+	defer cursor.Close(ctx)
+
+	count, failed := 0, false
+	for cursor.Next(ctx) {
+		var head struct {
+			Collection `bson:",inline"`
+			Content    string `bson:"content"`
+		}
+		if err := cursor.Decode(&head); err != nil {
+			log.Error("failed to decode template for revision backfill", zap.Error(err))
+			failed = true
+			continue
+		}
+
+		html := head.HtmlContent
+		if html == "" {
+			html = head.Content
+		}
+
+		record := RevisionCollection{
+			Id:           template.RevisionId(head.Id, head.Version),
+			Template:     head.Id,
+			Version:      head.Version,
+			Subject:      head.Subject,
+			HtmlContent:  html,
+			TextContent:  head.TextContent,
+			Placeholders: head.Placeholders,
+			CreatedAt:    head.UpdatedAt,
+		}
+
+		res, err := revisions.UpdateOne(ctx, bson.M{"_id": record.Id}, bson.M{
+			"$setOnInsert": record,
+		}, options.Update().SetUpsert(true))
+		if err != nil {
+			log.Error("failed to backfill template revision",
+				zap.String("template", head.Id),
+				zap.Error(err),
+			)
+			failed = true
+			continue
+		}
+
+		if res.UpsertedCount > 0 {
+			count++
+		}
+	}
+
+	if err := cursor.Err(); err != nil {
+		log.Error("failed to backfill template revisions", zap.Error(err))
+		return
+	}
+
+	if count > 0 {
+		log.Info("backfilled template revisions", zap.Int("count", count))
+	}
+
+	if failed {
+		return
+	}
+
+	if _, err := markers.UpdateOne(ctx, bson.M{"_id": backfillMarker}, bson.M{
+		"$setOnInsert": bson.M{"completed_at": time.Now()},
+	}, options.Update().SetUpsert(true)); err != nil {
+		log.Error("failed to record template revision backfill", zap.Error(err))
+	}
+}
+
+// ReadRevisions implements template.Repository.
+func (c *repository) ReadRevisions(ctx context.Context, request dto.Request) (map[string]any, error) {
+	ctx, span := c.tracer.Start(ctx, "infrastructure.repository.template.read_revisions", trace.WithAttributes(
+		attribute.String("operation", "read_revisions"),
+		attribute.String("payload", fmt.Sprintf("%+v", request)),
+	))
+	defer span.End()
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID().String()
+	mongodb.RegisterType("template_revision", template.Revision{})
+	typeHints := map[string]string{}
+
+	for _, v := range request.Queries {
+		typeHints[v.Name] = "template_revision"
+	}
+
+	res, err := mongodb.RunFaceted(ctx, c.revisions, request)
+	if err != nil {
+		err = ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceID)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		c.log.Error("Failed to fetch revisions by request",
+			zap.String("operation", "read_revisions"),
+			zap.String("trace_id", traceID),
+			zap.Any("payload", request),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	records, err := mongodb.CastFacetedResult(res, typeHints)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.log.Error("Failed to cast faceted result",
+			zap.String("operation", "read_revisions"),
+			zap.String("trace_id", traceID),
+			zap.Any("payload", request),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// ReadOneRevision implements template.Repository.
+func (c *repository) ReadOneRevision(ctx context.Context, request dto.Request) (*template.Revision, error) {
+	ctx, span := c.tracer.Start(ctx, "infrastructure.repository.template.read_one_revision", trace.WithAttributes(
+		attribute.String("operation", "read_one_revision"),
+		attribute.String("dto", fmt.Sprintf("%v", request))),
+	)
+	defer span.End()
+
+	traceId := trace.SpanContextFromContext(ctx).TraceID().String()
+
+	res, err := c.ReadRevisions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []template.Revision
+	if raw, ok := res["one"]; ok {
+		if err := common.JsonToAny(raw, &records); err != nil {
+			err := ose_error.Wrap(err, ose_error.ErrInternalServerError, err.Error(), traceId)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			c.log.Error("failed to cast revision",
+				zap.String("trace_id", traceId),
+				zap.String("operation", "read_one_revision"),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+	}
+
+	if len(records) == 0 {
+		err := ose_error.New(ose_error.ErrNotFound, "template revision not found", traceId)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		c.log.Error("failed to fetch revision",
+			zap.String("trace_id", traceId),
+			zap.String("operation", "read_one_revision"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &records[0], nil
+}
@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+const nameGeneric = "generic"
+
+// Generic accepts events already in postman's own shape, for providers or
+// relays without a dedicated parser. The body is a JSON array of events signed
+// with X-Postman-Signature, the hex HMAC-SHA256 of "timestamp.body" keyed with
+// the shared secret, where timestamp is the X-Postman-Timestamp header.
+type Generic struct {
+	secret string
+}
+
+type genericEvent struct {
+	Type       Type    `json:"type"`
+	MessageId  string  `json:"message_id"`
+	ProviderId string  `json:"provider_id"`
+	Recipient  string  `json:"recipient"`
+	Status     string  `json:"status"`
+	Diagnostic string  `json:"diagnostic"`
+	Permanent  bool    `json:"permanent"`
+	Url        string  `json:"url"`
+	Timestamp  float64 `json:"timestamp"`
+}
+
+// NewGeneric builds the generic parser around the shared signing secret.
+func NewGeneric(secret string) (Parser, error) {
+	if secret == "" {
+		return nil, errors.New("generic webhook secret is required")
+	}
+
+	return &Generic{secret: secret}, nil
+}
+
+// Name implements Parser.
+func (g *Generic) Name() string {
+	return nameGeneric
+}
+
+// Verify implements Parser.
+func (g *Generic) Verify(header http.Header, body []byte) error {
+	timestamp := header.Get(HeaderTimestamp)
+	if err := fresh(timestamp, time.Now()); err != nil {
+		return err
+	}
+
+	expected := Sign(g.secret, timestamp, body)
+	if !hmac.Equal([]byte(header.Get(HeaderSignature)), []byte(expected)) {
+		return ErrSignature
+	}
+
+	return nil
+}
+
+// Parse implements Parser.
+func (g *Generic) Parse(body []byte) ([]Event, error) {
+	var payload []genericEvent
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	events := make([]Event, 0, len(payload))
+	for _, item := range payload {
+		switch item.Type {
+		case TypeDelivered, TypeDeferred, TypeBounced, TypeComplained, TypeOpened, TypeClicked:
+		default:
+			continue
+		}
+
+		events = append(events, Event{
+			Type:       item.Type,
+			MessageId:  item.MessageId,
+			ProviderId: item.ProviderId,
+			Recipient:  item.Recipient,
+			Status:     item.Status,
+			Diagnostic: item.Diagnostic,
+			Permanent:  item.Permanent,
+			Url:        item.Url,
+			At:         unix(item.Timestamp),
+		})
+	}
+
+	return events, nil
+}
+
+var _ Parser = (*Generic)(nil)
@@ -0,0 +1,137 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const nameMailgun = "mailgun"
+
+// Mailgun parses Mailgun webhooks. The signature travels in the body: the hex
+// HMAC-SHA256 of timestamp followed by token, keyed with the webhook signing key.
+type Mailgun struct {
+	key []byte
+}
+
+type mailgunPayload struct {
+	Signature struct {
+		Timestamp string `json:"timestamp"`
+		Token     string `json:"token"`
+		Signature string `json:"signature"`
+	} `json:"signature"`
+	EventData mailgunEvent `json:"event-data"`
+}
+
+type mailgunEvent struct {
+	Event     string  `json:"event"`
+	Severity  string  `json:"severity"`
+	Reason    string  `json:"reason"`
+	Recipient string  `json:"recipient"`
+	Timestamp float64 `json:"timestamp"`
+	Url       string  `json:"url"`
+	Message   struct {
+		Headers struct {
+			MessageId string `json:"message-id"`
+		} `json:"headers"`
+	} `json:"message"`
+	DeliveryStatus struct {
+		Code        int    `json:"code"`
+		Message     string `json:"message"`
+		Description string `json:"description"`
+	} `json:"delivery-status"`
+}
+
+// NewMailgun builds the Mailgun parser around the webhook signing key.
+func NewMailgun(secret string) (Parser, error) {
+	if secret == "" {
+		return nil, errors.New("mailgun webhook signing key is required")
+	}
+
+	return &Mailgun{key: []byte(secret)}, nil
+}
+
+// Name implements Parser.
+func (m *Mailgun) Name() string {
+	return nameMailgun
+}
+
+// Verify implements Parser.
+func (m *Mailgun) Verify(_ http.Header, body []byte) error {
+	var payload mailgunPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return ErrSignature
+	}
+
+	signature := payload.Signature
+	if err := fresh(signature.Timestamp, time.Now()); err != nil {
+		return err
+	}
+
+	expected, err := hex.DecodeString(signature.Signature)
+	if err != nil {
+		return ErrSignature
+	}
+
+	mac := hmac.New(sha256.New, m.key)
+	mac.Write([]byte(signature.Timestamp + signature.Token))
+	if !hmac.Equal(expected, mac.Sum(nil)) {
+		return ErrSignature
+	}
+
+	return nil
+}
+
+// Parse implements Parser. Mailgun posts one event per callback.
+func (m *Mailgun) Parse(body []byte) ([]Event, error) {
+	var payload mailgunPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	item := payload.EventData
+	event := Event{
+		MessageId:  item.Message.Headers.MessageId,
+		Recipient:  item.Recipient,
+		Diagnostic: item.DeliveryStatus.Description,
+		Url:        item.Url,
+		At:         unix(item.Timestamp),
+	}
+	if event.Diagnostic == "" {
+		event.Diagnostic = item.DeliveryStatus.Message
+	}
+	if item.DeliveryStatus.Code != 0 {
+		event.Status = strconv.Itoa(item.DeliveryStatus.Code)
+	}
+
+	switch item.Event {
+	case "delivered":
+		event.Type = TypeDelivered
+	case "failed":
+		if item.Severity == "temporary" {
+			event.Type = TypeDeferred
+			break
+		}
+
+		event.Type = TypeBounced
+		// suppress-bounce means Mailgun dropped it for an earlier bounce
+		event.Permanent = item.Reason != "suppress-bounce"
+	case "complained":
+		event.Type = TypeComplained
+	case "opened":
+		event.Type = TypeOpened
+	case "clicked":
+		event.Type = TypeClicked
+	default:
+		return nil, nil
+	}
+
+	return []Event{event}, nil
+}
+
+var _ Parser = (*Mailgun)(nil)
@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Headers of the callbacks postman sends, and of those the generic parser accepts.
+const (
+	HeaderSignature = "X-Postman-Signature"
+	HeaderTimestamp = "X-Postman-Timestamp"
+	HeaderDelivery  = "X-Postman-Delivery"
+	HeaderEvent     = "X-Postman-Event"
+)
+
+// Sign returns the hex HMAC-SHA256 of "timestamp.body" keyed with secret.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// StatusError is returned when a subscriber answers a callback with a non-2xx status.
+type StatusError struct {
+	Status int
+	Body   string
+}
+
+func (s *StatusError) Error() string {
+	return fmt.Sprintf("subscriber responded %d: %s", s.Status, s.Body)
+}
+
+// Retryable reports whether a subscriber that answered status may accept the
+// callback later. Client errors other than timeouts and rate limits will not.
+func (s *StatusError) Retryable() bool {
+	return s.Status >= 500 || s.Status == http.StatusRequestTimeout || s.Status == http.StatusTooManyRequests
+}
+
+// Callback is one signed POST to a subscriber.
+type Callback struct {
+	Url    string
+	Secret string
+	// Id is the delivery id, stable across retries so subscribers can drop duplicates.
+	Id    string
+	Event string
+	Body  []byte
+}
+
+// Sender posts signed callbacks.
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender builds a sender; timeouts come from the context of each Post.
+func NewSender() *Sender {
+	return &Sender{client: &http.Client{}}
+}
+
+// Post delivers callback and returns the status the subscriber answered with.
+func (s *Sender) Post(ctx context.Context, callback Callback) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, callback.Url, bytes.NewReader(callback.Body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(HeaderTimestamp, timestamp)
+	request.Header.Set(HeaderSignature, Sign(callback.Secret, timestamp, callback.Body))
+	request.Header.Set(HeaderDelivery, callback.Id)
+	request.Header.Set(HeaderEvent, callback.Event)
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	text, _ := io.ReadAll(io.LimitReader(response.Body, 4<<10))
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.StatusCode, &StatusError{Status: response.StatusCode, Body: string(text)}
+	}
+
+	return response.StatusCode, nil
+}
@@ -0,0 +1,131 @@
+package webhook
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const nameSendGrid = "sendgrid"
+
+// SendGrid parses the SendGrid event webhook. Callbacks are signed with ECDSA
+// over the timestamp header followed by the body; the secret is the base64
+// verification key shown in the SendGrid console.
+type SendGrid struct {
+	key *ecdsa.PublicKey
+}
+
+type sendGridEvent struct {
+	Event       string  `json:"event"`
+	Email       string  `json:"email"`
+	Timestamp   float64 `json:"timestamp"`
+	SmtpId      string  `json:"smtp-id"`
+	MessageId   string  `json:"sg_message_id"`
+	Reason      string  `json:"reason"`
+	Response    string  `json:"response"`
+	Status      string  `json:"status"`
+	Type        string  `json:"type"`
+	Url         string  `json:"url"`
+	BounceClass string  `json:"bounce_classification"`
+}
+
+// NewSendGrid builds the SendGrid parser around its verification key.
+func NewSendGrid(secret string) (Parser, error) {
+	der, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+
+	ecdsaKey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("sendgrid verification key is not an ECDSA key")
+	}
+
+	return &SendGrid{key: ecdsaKey}, nil
+}
+
+// Name implements Parser.
+func (s *SendGrid) Name() string {
+	return nameSendGrid
+}
+
+// Verify implements Parser.
+func (s *SendGrid) Verify(header http.Header, body []byte) error {
+	timestamp := header.Get("X-Twilio-Email-Event-Webhook-Timestamp")
+	if err := fresh(timestamp, time.Now()); err != nil {
+		return err
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(header.Get("X-Twilio-Email-Event-Webhook-Signature"))
+	if err != nil {
+		return ErrSignature
+	}
+
+	digest := sha256.Sum256(append([]byte(timestamp), body...))
+	if !ecdsa.VerifyASN1(s.key, digest[:], signature) {
+		return ErrSignature
+	}
+
+	return nil
+}
+
+// Parse implements Parser.
+func (s *SendGrid) Parse(body []byte) ([]Event, error) {
+	var payload []sendGridEvent
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	events := make([]Event, 0, len(payload))
+	for _, item := range payload {
+		event := Event{
+			MessageId: item.SmtpId,
+			// sg_message_id is the id returned on send with a filter suffix
+			ProviderId: strings.SplitN(item.MessageId, ".", 2)[0],
+			Recipient:  item.Email,
+			Status:     item.Status,
+			Diagnostic: item.Reason,
+			Url:        item.Url,
+			At:         unix(item.Timestamp),
+		}
+
+		switch item.Event {
+		case "delivered":
+			event.Type = TypeDelivered
+		case "deferred":
+			event.Type = TypeDeferred
+			event.Diagnostic = item.Response
+		case "bounce":
+			event.Type = TypeBounced
+			// blocked messages were refused for reputation, not the address
+			event.Permanent = item.Type != "blocked"
+		case "dropped":
+			event.Type = TypeBounced
+		case "spamreport":
+			event.Type = TypeComplained
+		case "open":
+			event.Type = TypeOpened
+		case "click":
+			event.Type = TypeClicked
+		default:
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+var _ Parser = (*SendGrid)(nil)
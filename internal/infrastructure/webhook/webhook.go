@@ -0,0 +1,138 @@
+// Package webhook verifies and parses the event callbacks email providers post
+// about messages they accepted, normalizing them into a common Event.
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrSignature is returned when a callback is unsigned, wrongly signed or stale.
+	ErrSignature = errors.New("webhook signature is invalid")
+	// ErrUnknownProvider is returned by New for names no parser is registered under.
+	ErrUnknownProvider = errors.New("unknown webhook provider")
+)
+
+// Type is what happened to a message.
+type Type string
+
+const (
+	TypeDelivered  Type = "delivered"
+	TypeDeferred   Type = "deferred"
+	TypeBounced    Type = "bounced"
+	TypeComplained Type = "complained"
+	TypeOpened     Type = "opened"
+	TypeClicked    Type = "clicked"
+)
+
+// Tolerance is how far a signed timestamp may drift from now before the
+// callback is rejected as a replay.
+const Tolerance = 5 * time.Minute
+
+// Event is one provider callback in provider-neutral form.
+type Event struct {
+	Type Type
+	// MessageId is the Message-ID header of the message, when the provider reports it.
+	MessageId string
+	// ProviderId is the provider's own id for the message.
+	ProviderId string
+	Recipient  string
+	// Status is the enhanced status code, e.g. 5.1.1, or the SMTP reply code.
+	Status     string
+	Diagnostic string
+	// Permanent marks a hard bounce.
+	Permanent bool
+	// Url is the link followed by a click.
+	Url string
+	At  time.Time
+}
+
+// Parser understands the callbacks of one provider.
+type Parser interface {
+	Name() string
+	// Verify checks the callback was signed by the provider.
+	Verify(header http.Header, body []byte) error
+	// Parse extracts the events of a verified callback, skipping event types
+	// that do not concern delivery.
+	Parse(body []byte) ([]Event, error)
+}
+
+// Factory builds a parser from the secret configured for it: a signing key or,
+// for providers that sign asymmetrically, their public key.
+type Factory func(secret string) (Parser, error)
+
+var (
+	mu       sync.RWMutex
+	registry = map[string]Factory{}
+)
+
+// Register makes a parser available under name, replacing any earlier one.
+func Register(name string, factory Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	registry[name] = factory
+}
+
+// New builds the parser registered under name.
+func New(name, secret string) (Parser, error) {
+	mu.RLock()
+	factory, ok := registry[name]
+	mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, name)
+	}
+
+	return factory(secret)
+}
+
+// Names lists the registered parsers.
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func init() {
+	Register(nameGeneric, NewGeneric)
+	Register(nameSendGrid, NewSendGrid)
+	Register(nameMailgun, NewMailgun)
+}
+
+// fresh checks a unix timestamp in seconds is within Tolerance of now.
+func fresh(timestamp string, now time.Time) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrSignature
+	}
+
+	drift := now.Sub(time.Unix(seconds, 0))
+	if drift > Tolerance || drift < -Tolerance {
+		return fmt.Errorf("%w: timestamp outside tolerance", ErrSignature)
+	}
+
+	return nil
+}
+
+// unix converts fractional unix seconds, as providers send them, to a time.
+func unix(seconds float64) time.Time {
+	if seconds <= 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, int64(seconds*float64(time.Second))).UTC()
+}
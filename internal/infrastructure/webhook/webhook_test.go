@@ -0,0 +1,375 @@
+package webhook
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestParseFixtures(t *testing.T) {
+	generic, err := New(nameGeneric, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mailgun, err := New(nameMailgun, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Parse never touches the verification key
+	sendgrid := &SendGrid{}
+
+	tests := []struct {
+		file   string
+		parser Parser
+		events []Event
+	}{
+		{
+			file:   "generic.json",
+			parser: generic,
+			events: []Event{
+				{
+					Type:      TypeDelivered,
+					MessageId: "<eml_01HZX3Q7.1@mail.example.com>",
+					Recipient: "ada@example.org",
+					Status:    "2.0.0",
+					At:        time.Unix(1717430400, 0),
+				},
+				{
+					Type:       TypeBounced,
+					ProviderId: "msg_7f3a9c",
+					Recipient:  "grace@example.org",
+					Status:     "5.1.1",
+					Diagnostic: "smtp; 550 5.1.1 user unknown",
+					Permanent:  true,
+					At:         time.Unix(1717430460, 0),
+				},
+			},
+		},
+		{
+			file:   "sendgrid.json",
+			parser: sendgrid,
+			events: []Event{
+				{
+					Type:       TypeDelivered,
+					MessageId:  "<eml_01HZX3Q7.1@mail.example.com>",
+					ProviderId: "14c5d75ce93",
+					Recipient:  "ada@example.org",
+					At:         time.Unix(1717430405, 0),
+				},
+				{
+					Type:       TypeDeferred,
+					MessageId:  "<eml_01HZX3Q8.1@mail.example.com>",
+					ProviderId: "14c5d75ce94",
+					Recipient:  "grace@example.org",
+					Diagnostic: "400 try again later",
+					At:         time.Unix(1717430410, 0),
+				},
+				{
+					Type:       TypeBounced,
+					MessageId:  "<eml_01HZX3Q9.1@mail.example.com>",
+					ProviderId: "14c5d75ce95",
+					Recipient:  "linus@example.org",
+					Status:     "5.1.1",
+					Diagnostic: "550 5.1.1 The email account that you tried to reach does not exist",
+					Permanent:  true,
+					At:         time.Unix(1717430415, 0),
+				},
+				{
+					Type:       TypeBounced,
+					MessageId:  "<eml_01HZX3QA.1@mail.example.com>",
+					ProviderId: "14c5d75ce96",
+					Recipient:  "margaret@example.org",
+					Status:     "5.7.1",
+					Diagnostic: "554 5.7.1 Service unavailable; client host blocked",
+					At:         time.Unix(1717430420, 0),
+				},
+				{
+					Type:       TypeOpened,
+					ProviderId: "14c5d75ce93",
+					Recipient:  "ada@example.org",
+					At:         time.Unix(1717430500, 0),
+				},
+				{
+					Type:       TypeClicked,
+					ProviderId: "14c5d75ce93",
+					Recipient:  "ada@example.org",
+					Url:        "https://example.com/welcome",
+					At:         time.Unix(1717430510, 0),
+				},
+				{
+					Type:       TypeComplained,
+					ProviderId: "14c5d75ce93",
+					Recipient:  "ada@example.org",
+					At:         time.Unix(1717430600, 0),
+				},
+			},
+		},
+		{
+			file:   "mailgun_delivered.json",
+			parser: mailgun,
+			events: []Event{
+				{
+					Type:       TypeDelivered,
+					MessageId:  "eml_01HZX3Q7.1@mail.example.com",
+					Recipient:  "ada@example.org",
+					Status:     "250",
+					Diagnostic: "OK",
+					At:         time.Unix(1717430405, 123e6),
+				},
+			},
+		},
+		{
+			file:   "mailgun_failed.json",
+			parser: mailgun,
+			events: []Event{
+				{
+					Type:       TypeBounced,
+					MessageId:  "eml_01HZX3Q9.1@mail.example.com",
+					Recipient:  "linus@example.org",
+					Status:     "550",
+					Diagnostic: "5.1.1 The email account that you tried to reach does not exist",
+					Permanent:  true,
+					At:         time.Unix(1717430415, 456e6),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			body, err := os.ReadFile(filepath.Join("testdata", test.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			events, err := test.parser.Parse(body)
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			if len(events) != len(test.events) {
+				t.Fatalf("events = %d, want %d: %+v", len(events), len(test.events), events)
+			}
+
+			for i, want := range test.events {
+				got := events[i]
+				// float timestamps lose a little precision below the millisecond
+				if got.At.Sub(want.At).Abs() > time.Millisecond {
+					t.Errorf("event %d at = %v, want %v", i, got.At, want.At)
+				}
+
+				got.At, want.At = time.Time{}, time.Time{}
+				if got != want {
+					t.Errorf("event %d = %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseMailgunTemporary(t *testing.T) {
+	parser := &Mailgun{}
+	body := []byte(`{"event-data":{"event":"failed","severity":"temporary","recipient":"ada@example.org"}}`)
+
+	events, err := parser.Parse(body)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if len(events) != 1 || events[0].Type != TypeDeferred || events[0].Permanent {
+		t.Fatalf("events = %+v, want one transient deferral", events)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	stale := strconv.FormatInt(time.Now().Add(-2*Tolerance).Unix(), 10)
+	body := []byte(`[{"type":"delivered","message_id":"<1@example.com>"}]`)
+
+	generic, err := New(nameGeneric, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	genericHeader := func(timestamp, signature string) http.Header {
+		header := http.Header{}
+		header.Set(HeaderTimestamp, timestamp)
+		header.Set(HeaderSignature, signature)
+		return header
+	}
+
+	mailgun, err := New(nameMailgun, "key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mailgunSign := func(timestamp, token string) string {
+		mac := hmac.New(sha256.New, []byte("key"))
+		mac.Write([]byte(timestamp + token))
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+	mailgunBody := func(timestamp, token, signature string) []byte {
+		return fmt.Appendf(nil, `{"signature":{"timestamp":%q,"token":%q,"signature":%q},"event-data":{"event":"delivered"}}`,
+			timestamp, token, signature)
+	}
+
+	private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&private.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sendgrid, err := New(nameSendGrid, base64.StdEncoding.EncodeToString(der))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sendgridSign := func(timestamp string, body []byte) string {
+		digest := sha256.Sum256(append([]byte(timestamp), body...))
+		signature, err := ecdsa.SignASN1(rand.Reader, private, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.StdEncoding.EncodeToString(signature)
+	}
+	sendgridHeader := func(timestamp, signature string) http.Header {
+		header := http.Header{}
+		header.Set("X-Twilio-Email-Event-Webhook-Timestamp", timestamp)
+		header.Set("X-Twilio-Email-Event-Webhook-Signature", signature)
+		return header
+	}
+
+	tests := []struct {
+		name   string
+		parser Parser
+		header http.Header
+		body   []byte
+		valid  bool
+	}{
+		{
+			name:   "generic valid",
+			parser: generic,
+			header: genericHeader(now, Sign("secret", now, body)),
+			body:   body,
+			valid:  true,
+		},
+		{
+			name:   "generic tampered body",
+			parser: generic,
+			header: genericHeader(now, Sign("secret", now, body)),
+			body:   []byte(`[{"type":"bounced","message_id":"<1@example.com>"}]`),
+		},
+		{
+			name:   "generic wrong secret",
+			parser: generic,
+			header: genericHeader(now, Sign("other", now, body)),
+			body:   body,
+		},
+		{
+			name:   "generic stale timestamp",
+			parser: generic,
+			header: genericHeader(stale, Sign("secret", stale, body)),
+			body:   body,
+		},
+		{
+			name:   "generic missing timestamp",
+			parser: generic,
+			header: genericHeader("", Sign("secret", "", body)),
+			body:   body,
+		},
+		{
+			name:   "generic malformed signature",
+			parser: generic,
+			header: genericHeader(now, "not hex"),
+			body:   body,
+		},
+		{
+			name:   "mailgun valid",
+			parser: mailgun,
+			body:   mailgunBody(now, "token", mailgunSign(now, "token")),
+			valid:  true,
+		},
+		{
+			name:   "mailgun tampered token",
+			parser: mailgun,
+			body:   mailgunBody(now, "other", mailgunSign(now, "token")),
+		},
+		{
+			name:   "mailgun stale timestamp",
+			parser: mailgun,
+			body:   mailgunBody(stale, "token", mailgunSign(stale, "token")),
+		},
+		{
+			name:   "mailgun missing timestamp",
+			parser: mailgun,
+			body:   mailgunBody("", "token", mailgunSign("", "token")),
+		},
+		{
+			name:   "mailgun malformed signature",
+			parser: mailgun,
+			body:   mailgunBody(now, "token", "not hex"),
+		},
+		{
+			name:   "mailgun malformed body",
+			parser: mailgun,
+			body:   []byte("not json"),
+		},
+		{
+			name:   "sendgrid valid",
+			parser: sendgrid,
+			header: sendgridHeader(now, sendgridSign(now, body)),
+			body:   body,
+			valid:  true,
+		},
+		{
+			name:   "sendgrid tampered body",
+			parser: sendgrid,
+			header: sendgridHeader(now, sendgridSign(now, body)),
+			body:   []byte(`[{"event":"bounce"}]`),
+		},
+		{
+			name:   "sendgrid stale timestamp",
+			parser: sendgrid,
+			header: sendgridHeader(stale, sendgridSign(stale, body)),
+			body:   body,
+		},
+		{
+			name:   "sendgrid missing timestamp",
+			parser: sendgrid,
+			header: sendgridHeader("", sendgridSign("", body)),
+			body:   body,
+		},
+		{
+			name:   "sendgrid malformed signature",
+			parser: sendgrid,
+			header: sendgridHeader(now, "not base64!"),
+			body:   body,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.parser.Verify(test.header, test.body)
+			if test.valid {
+				if err != nil {
+					t.Fatalf("verify: %v", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, ErrSignature) {
+				t.Fatalf("err = %v, want ErrSignature", err)
+			}
+		})
+	}
+}